@@ -0,0 +1,276 @@
+package sumhash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// batchWidth is the number of messages whose compressions are interleaved.
+// A Matrix compresses batchWidthMatrix messages at once, with CompressBatch.
+const (
+	batchWidth       = 4
+	batchWidthMatrix = 64
+)
+
+// batchMinPerWorker is the number of messages below which SumMany does not
+// start another goroutine.
+const batchMinPerWorker = 64
+
+// SumMany computes the sumhash checksums of msgs with the compressor c, in
+// unsalted mode, and stores them in out. It gives the same outputs as New(c,
+// nil), and is faster when hashing many messages: if c is a LookupTable, the
+// compressions of four messages are interleaved, so that they share the loops
+// over the lookup table. With AVX2, each gather then loads the entries of one
+// column for the four messages. The messages are also split across up to
+// GOMAXPROCS goroutines. The messages need not have the same length.
+//
+// If c is a Matrix, the compressions use Matrix.CompressBatch, so that the
+// checksums are computed in constant time.
+//
+// The output length of c must be 64 bytes, len(out) must equal len(msgs), and
+// c must be safe for concurrent use, as are all the compressors of this
+// package.
+func SumMany(c Compressor, msgs [][]byte, out [][64]byte) {
+	sumMany(c, nil, msgs, out)
+}
+
+// SumManySalted is like SumMany, but computes the checksums in salted mode,
+// as New(c, salt) does. salt must be BlockSize(c) bytes.
+func SumManySalted(c Compressor, salt []byte, msgs [][]byte, out [][64]byte) {
+	if len(salt) != BlockSize(c) {
+		panic(fmt.Sprintf("bad salt size: want %d, got %d", BlockSize(c), len(salt)))
+	}
+	sumMany(c, salt, msgs, out)
+}
+
+func sumMany(c Compressor, salt []byte, msgs [][]byte, out [][64]byte) {
+	if c.OutputLen() != 64 {
+		panic(fmt.Errorf("output length of the compressor is %d, expected 64", c.OutputLen()))
+	}
+	if len(out) != len(msgs) {
+		panic(fmt.Errorf("output has %d elements for %d messages", len(out), len(msgs)))
+	}
+	if _, ok := c.(lazyCompressor); ok {
+		c = sumhash512Instance().c
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if w := len(msgs) / batchMinPerWorker; w < workers {
+		workers = w
+	}
+	if workers <= 1 {
+		newBatch(c, salt).sum(msgs, out)
+		return
+	}
+
+	var wg sync.WaitGroup
+	per := (len(msgs) + workers - 1) / workers
+	for start := 0; start < len(msgs); start += per {
+		end := start + per
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		wg.Add(1)
+		go func(msgs [][]byte, out [][64]byte) {
+			defer wg.Done()
+			newBatch(c, salt).sum(msgs, out)
+		}(msgs[start:end], out[start:end])
+	}
+	wg.Wait()
+}
+
+// batch holds the buffers of concurrent hash computations.
+type batch struct {
+	c      Compressor
+	table  LookupTable // c, if it is a LookupTable
+	matrix Matrix      // c, if it is a Matrix
+	salt   []byte
+	lanes  []batchLane
+
+	dst, cin [][]byte // inputs of CompressBatch
+}
+
+// batchLane is the state of one hash computation of a batch. The padded
+// message is a block of zeros if salted, then data, then tail.
+type batchLane struct {
+	zero    bool
+	data    []byte
+	tail    []byte
+	pad     []byte // storage for tail
+	cin     []byte // chain value, then next block
+	h       []byte // output of the last compression
+	pending bool   // cin holds a block to compress
+}
+
+func newBatch(c Compressor, salt []byte) *batch {
+	b := &batch{c: c, salt: salt}
+	b.table, _ = c.(LookupTable)
+	b.matrix, _ = c.(Matrix)
+	width := batchWidth
+	if b.matrix != nil {
+		width = batchWidthMatrix
+		b.dst = make([][]byte, 0, width)
+		b.cin = make([][]byte, 0, width)
+	}
+	b.lanes = make([]batchLane, width)
+	for i := range b.lanes {
+		b.lanes[i].pad = make([]byte, 2*BlockSize(c))
+		b.lanes[i].cin = make([]byte, c.InputLen())
+		b.lanes[i].h = make([]byte, c.OutputLen())
+	}
+	return b
+}
+
+// sum hashes msgs into out, len(b.lanes) messages at a time.
+func (b *batch) sum(msgs [][]byte, out [][64]byte) {
+	for len(msgs) > 0 {
+		n := len(msgs)
+		if n > len(b.lanes) {
+			n = len(b.lanes)
+		}
+		for i := 0; i < n; i++ {
+			b.lanes[i].reset(msgs[i], BlockSize(b.c), b.salt != nil)
+		}
+
+		for {
+			active := 0
+			for i := 0; i < n; i++ {
+				if b.lanes[i].next(b.salt) {
+					active++
+				}
+			}
+			if active == 0 {
+				break
+			}
+			b.compress(n, active)
+		}
+
+		for i := 0; i < n; i++ {
+			copy(out[i][:], b.lanes[i].h)
+		}
+		msgs = msgs[n:]
+		out = out[n:]
+	}
+}
+
+// reset prepares the lane to hash msg: it splits msg into its full blocks and
+// the padded tail.
+func (l *batchLane) reset(msg []byte, blockSize int, salted bool) {
+	if uint64(len(msg)) >= (1<<61)-uint64(blockSize) {
+		panic(fmt.Errorf("length overflow: trying to hash %d bytes", len(msg)))
+	}
+	for i := range l.h {
+		l.h[i] = 0 // all-zeros initialization vector
+	}
+	l.zero = salted
+
+	full := len(msg) / blockSize * blockSize
+	l.data = msg[:full]
+	rest := msg[full:]
+
+	// Padding, as in Digest.checkSum
+	l.tail = l.pad[:blockSize]
+	if len(rest) >= blockSize-16 {
+		l.tail = l.pad
+	}
+	for i := range l.tail {
+		l.tail[i] = 0
+	}
+	copy(l.tail, rest)
+	l.tail[len(rest)] = 0x01
+	bitlen := uint64(len(msg)) << 3
+	if salted {
+		bitlen += uint64(blockSize) << 3
+	}
+	binary.LittleEndian.PutUint64(l.tail[len(l.tail)-16:], bitlen)
+}
+
+// next sets the compression input to the chain value and the next block of
+// the padded message, XORed with the salt. It returns false when the message
+// is fully compressed.
+func (l *batchLane) next(salt []byte) bool {
+	size := len(l.h)
+	blockSize := len(l.cin) - size
+	copy(l.cin, l.h)
+	block := l.cin[size:]
+
+	var input []byte
+	switch {
+	case l.zero:
+		// An initial block of zeros, effectively prepending the salt to the input.
+		l.zero = false
+		copy(block, salt)
+		l.pending = true
+		return true
+	case len(l.data) > 0:
+		input, l.data = l.data[:blockSize], l.data[blockSize:]
+	case len(l.tail) > 0:
+		input, l.tail = l.tail[:blockSize], l.tail[blockSize:]
+	default:
+		l.pending = false
+		return false
+	}
+
+	if salt != nil {
+		xorBytes(block, input, salt)
+	} else {
+		copy(block, input)
+	}
+	l.pending = true
+	return true
+}
+
+// compress compresses the inputs of the pending lanes among the first n.
+// Messages may have different lengths, so some lanes may be done before the
+// others.
+func (b *batch) compress(n int, active int) {
+	if b.table != nil && active == batchWidth {
+		lookupTableCompress4(b.table, b.lanes)
+		return
+	}
+	if b.matrix != nil {
+		b.dst, b.cin = b.dst[:0], b.cin[:0]
+		for i := 0; i < n; i++ {
+			if l := &b.lanes[i]; l.pending {
+				b.dst = append(b.dst, l.h)
+				b.cin = append(b.cin, l.cin)
+			}
+		}
+		b.matrix.CompressBatch(b.dst, b.cin)
+		return
+	}
+	for i := 0; i < n; i++ {
+		if l := &b.lanes[i]; l.pending {
+			b.c.Compress(l.h, l.cin)
+		}
+	}
+}
+
+// lookupTableCompress4Generic is the pure Go implementation of
+// lookupTableCompress4, which is LookupTable.Compress on the inputs of the
+// first four lanes at once. The loads of the four lookups in a column are
+// independent, and share the loop and the indexing of the column.
+func lookupTableCompress4Generic(A LookupTable, lanes []batchLane) {
+	lanes = lanes[:4]
+	m0, m1, m2, m3 := lanes[0].cin, lanes[1].cin, lanes[2].cin, lanes[3].cin
+	n := A.InputLen()
+	_, _, _, _ = m0[n-1], m1[n-1], m2[n-1], m3[n-1]
+
+	for i := range A {
+		var x0, x1, x2, x3 uint64
+		row := A[i][:n]
+		for j := range row {
+			col := &row[j]
+			x0 += col[m0[j]]
+			x1 += col[m1[j]]
+			x2 += col[m2[j]]
+			x3 += col[m3[j]]
+		}
+		binary.LittleEndian.PutUint64(lanes[0].h[8*i:], x0)
+		binary.LittleEndian.PutUint64(lanes[1].h[8*i:], x1)
+		binary.LittleEndian.PutUint64(lanes[2].h[8*i:], x2)
+		binary.LittleEndian.PutUint64(lanes[3].h[8*i:], x3)
+	}
+}
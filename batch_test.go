@@ -0,0 +1,157 @@
+package sumhash
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+)
+
+func batchMessages(count int, length func(i int) int) [][]byte {
+	msgs := make([][]byte, count)
+	for i := range msgs {
+		msgs[i] = make([]byte, length(i))
+		for j := range msgs[i] {
+			msgs[i][j] = byte(i + 31*j)
+		}
+	}
+	return msgs
+}
+
+func TestSumMany(t *testing.T) {
+	salt := make([]byte, 96)
+	for i := range salt {
+		salt[i] = byte(i)
+	}
+	A, err := RandomMatrixFromSeed([]byte("batch"), 8, 1280)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compressors := []struct {
+		name string
+		c    Compressor
+	}{
+		{"sumhash512", SumhashCompressor},
+		{"Matrix", A},
+		{"LookupTable", A.LookupTable()},
+		{"NibbleTable", A.NibbleTable()},
+	}
+	lengths := []struct {
+		name   string
+		length func(i int) int
+	}{
+		{"equal", func(i int) int { return 100 }},
+		{"varying", func(i int) int { return i * 13 % 300 }},
+	}
+
+	// Without AVX2, the lookup tables are used by the 4-lane compression.
+	defer func(saved bool) { useAVX2 = saved }(useAVX2)
+	avx := []bool{false}
+	if useAVX2 {
+		avx = append(avx, true)
+	}
+
+	for _, useAVX2 = range avx {
+		for _, c := range compressors {
+			for _, l := range lengths {
+				for _, count := range []int{0, 1, 3, 4, 7, 300} {
+					t.Run(fmt.Sprintf("avx2=%v/%s/%s/%d", useAVX2, c.name, l.name, count), func(t *testing.T) {
+						msgs := batchMessages(count, l.length)
+						out := make([][64]byte, count)
+						outSalted := make([][64]byte, count)
+						SumMany(c.c, msgs, out)
+						SumManySalted(c.c, salt[:BlockSize(c.c)], msgs, outSalted)
+
+						for i, msg := range msgs {
+							h := New(c.c, nil)
+							h.Write(msg)
+							if want := h.Sum(nil); !bytes.Equal(out[i][:], want) {
+								t.Errorf("message %d: got %x, want %x", i, out[i], want)
+							}
+							h = New(c.c, salt[:BlockSize(c.c)])
+							h.Write(msg)
+							if want := h.Sum(nil); !bytes.Equal(outSalted[i][:], want) {
+								t.Errorf("salted message %d: got %x, want %x", i, outSalted[i], want)
+							}
+						}
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestLookupTableCompress4(t *testing.T) {
+	for _, dims := range [][2]int{{8, 1024}, {14, 14 * 64 * 2}, {2, 200}, {3, 8 * 61}} {
+		A, err := RandomMatrix(rand.Reader, dims[0], dims[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		At := A.LookupTable()
+
+		lanes := make([]batchLane, 4)
+		for k := range lanes {
+			lanes[k].cin = make([]byte, A.InputLen())
+			lanes[k].h = make([]byte, A.OutputLen())
+		}
+		want := make([]byte, A.OutputLen())
+		for i := 0; i < 100; i++ {
+			for k := range lanes {
+				rand.Read(lanes[k].cin)
+			}
+			lookupTableCompress4(At, lanes)
+			for k := range lanes {
+				A.Compress(want, lanes[k].cin)
+				if !bytes.Equal(lanes[k].h, want) {
+					t.Fatalf("lane %d: compressed outputs differ (n=%d, m=%d)", k, dims[0], dims[1])
+				}
+			}
+		}
+	}
+}
+
+func TestSumManyPanics(t *testing.T) {
+	msgs := batchMessages(2, func(int) int { return 10 })
+	A, _ := RandomMatrixFromSeed([]byte("batch"), 4, 1024)
+
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"short output", func() { SumMany(SumhashCompressor, msgs, make([][64]byte, 1)) }},
+		{"output length", func() { SumMany(A, msgs, make([][64]byte, 2)) }},
+		{"salt size", func() { SumManySalted(SumhashCompressor, make([]byte, 32), msgs, make([][64]byte, 2)) }},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: no panic", test.name)
+				}
+			}()
+			test.f()
+		}()
+	}
+}
+
+func BenchmarkSumMany(b *testing.B) {
+	msgs := batchMessages(1024, func(int) int { return 200 })
+	out := make([][64]byte, len(msgs))
+	c := Sumhash512Compressor()
+
+	b.Run("SumMany", func(b *testing.B) {
+		b.SetBytes(int64(len(msgs) * 200))
+		for i := 0; i < b.N; i++ {
+			SumMany(c, msgs, out)
+		}
+	})
+	b.Run("Sum512", func(b *testing.B) {
+		b.SetBytes(int64(len(msgs) * 200))
+		for i := 0; i < b.N; i++ {
+			for j, msg := range msgs {
+				out[j] = Sum512(msg)
+			}
+		}
+	})
+}
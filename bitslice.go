@@ -0,0 +1,175 @@
+package sumhash
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// CompressBatch compresses each message of msgs into the corresponding
+// element of dst, as Compress does, 64 messages at a time.
+//
+// The messages of a group are bitsliced: each 64-bit word of the input is
+// loaded from the 64 messages and transposed, which gives, for every column of
+// the matrix, a bit-plane holding the bit of each message which selects that
+// column. A column is then loaded once, and added to the accumulators of the
+// 64 messages under a mask derived from the bit-plane. Like Compress, it runs
+// in constant time: the memory it accesses and its branches only depend on
+// the number of messages.
+func (A Matrix) CompressBatch(dst [][]byte, msgs [][]byte) {
+	if len(dst) != len(msgs) {
+		panic(fmt.Errorf("could not compress messages. %d outputs for %d messages", len(dst), len(msgs)))
+	}
+	for i := range msgs {
+		if len(msgs[i]) != A.InputLen() {
+			panic(fmt.Errorf("could not compress message. input size is wrong. size is %d, expected %d", len(msgs[i]), A.InputLen()))
+		}
+		if len(dst[i]) != A.OutputLen() {
+			panic(fmt.Errorf("could not compress message. output size is wrong size is %d, expected %d", len(dst[i]), A.OutputLen()))
+		}
+	}
+	if len(msgs) == 0 {
+		return
+	}
+
+	s := bitslicer{A: A, col: make([]uint64, len(A))}
+	if len(A) == 8 {
+		s.acc8 = new([64][8]uint64)
+	} else {
+		s.acc = make([]uint64, 64*len(A))
+	}
+	for len(msgs) > 0 {
+		n := len(msgs)
+		if n > 64 {
+			n = 64
+		}
+		s.compress64(dst[:n], msgs[:n])
+		dst = dst[n:]
+		msgs = msgs[n:]
+	}
+}
+
+// bitslicer holds the buffers of CompressBatch. acc holds the accumulators,
+// len(A) for each message, or acc8 when A has 8 rows. col holds a column of A.
+type bitslicer struct {
+	A    Matrix
+	acc  []uint64
+	acc8 *[64][8]uint64
+	col  []uint64
+}
+
+// compress64 compresses up to 64 messages.
+func (s *bitslicer) compress64(dst [][]byte, msgs [][]byte) {
+	A, acc, col := s.A, s.acc, s.col
+	for i := range acc {
+		acc[i] = 0
+	}
+	if s.acc8 != nil {
+		*s.acc8 = [64][8]uint64{}
+	}
+
+	var planes [64]uint64
+	var word [8]byte
+	rows := len(A)
+	for j := 0; j < len(msgs[0]); j += 8 {
+		for t := range planes {
+			planes[t] = 0
+		}
+		for t, msg := range msgs {
+			if j+8 <= len(msg) {
+				planes[t] = binary.LittleEndian.Uint64(msg[j:])
+			} else {
+				word = [8]byte{}
+				copy(word[:], msg[j:])
+				planes[t] = binary.LittleEndian.Uint64(word[:])
+			}
+		}
+		// After the transposition, bit t of planes[b] is bit b of the word of
+		// message t, which selects column 8*j+b.
+		transpose64(&planes)
+
+		bits := 8 * (len(msgs[0]) - j)
+		if bits > 64 {
+			bits = 64
+		}
+		if s.acc8 != nil {
+			for b := 0; b < bits; b += 8 {
+				s.accumulateByte8(planes[b:b+8], 8*j+b)
+			}
+			continue
+		}
+		for b := 0; b < bits; b++ {
+			k := 8*j + b
+			for i := range col {
+				if traceAccess && traceMatrixAccess != nil {
+					traceMatrixAccess(i, k)
+				}
+				col[i] = A[i][k]
+			}
+			w := planes[b]
+			for t := 0; t < len(msgs); t++ {
+				mask := -(w >> uint(t) & 1)
+				a := acc[t*rows : t*rows+rows]
+				for i := range a {
+					a[i] += col[i] & mask
+				}
+			}
+		}
+	}
+
+	for t := range msgs {
+		for i := 0; i < rows; i++ {
+			x := uint64(0)
+			if s.acc8 != nil {
+				x = s.acc8[t][i]
+			} else {
+				x = acc[t*rows+i]
+			}
+			binary.LittleEndian.PutUint64(dst[t][8*i:8*i+8], x)
+		}
+	}
+}
+
+// accumulateByte8 adds the columns k to k+7 of a matrix with 8 rows, such as
+// the one of sumhash512, to the accumulators of the messages selected by the
+// bit-planes w. The bits of w beyond the number of messages are zero, so all
+// 64 accumulators are updated.
+func (s *bitslicer) accumulateByte8(w []uint64, k int) {
+	var cols [8][8]uint64
+	for i := range cols {
+		if traceAccess && traceMatrixAccess != nil {
+			traceMatrixAccess(i, k)
+		}
+		copy(cols[i][:], s.A[i][k:k+8])
+	}
+	w0, w1, w2, w3, w4, w5, w6, w7 := w[0], w[1], w[2], w[3], w[4], w[5], w[6], w[7]
+	for t := range s.acc8 {
+		m0 := -(w0 >> uint(t) & 1)
+		m1 := -(w1 >> uint(t) & 1)
+		m2 := -(w2 >> uint(t) & 1)
+		m3 := -(w3 >> uint(t) & 1)
+		m4 := -(w4 >> uint(t) & 1)
+		m5 := -(w5 >> uint(t) & 1)
+		m6 := -(w6 >> uint(t) & 1)
+		m7 := -(w7 >> uint(t) & 1)
+		a := &s.acc8[t]
+		for i := range a {
+			c := &cols[i]
+			a[i] += c[0]&m0 + c[1]&m1 + c[2]&m2 + c[3]&m3 + c[4]&m4 + c[5]&m5 + c[6]&m6 + c[7]&m7
+		}
+	}
+}
+
+// transpose64 transposes a 64-by-64 bit matrix in place: bit c of a[r] is
+// swapped with bit r of a[c]. It swaps the off-diagonal blocks of size 32,
+// then 16, and so on, with masks and shifts only.
+func transpose64(a *[64]uint64) {
+	m := uint64(0x00000000ffffffff)
+	for j := uint(32); j != 0; j >>= 1 {
+		for k := uint(0); k < 64; k = ((k | j) + 1) &^ j {
+			t := (a[k]>>j ^ a[k|j]) & m
+			a[k] ^= t << j
+			a[k|j] ^= t
+		}
+		m ^= m << (j >> 1)
+	}
+}
@@ -0,0 +1,123 @@
+package sumhash
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTranspose64(t *testing.T) {
+	var a, b [64]uint64
+	buf := make([]byte, 8)
+	for i := range a {
+		rand.Read(buf)
+		for j := range buf {
+			a[i] |= uint64(buf[j]) << (8 * uint(j))
+		}
+	}
+	b = a
+	transpose64(&b)
+	for r := 0; r < 64; r++ {
+		for c := 0; c < 64; c++ {
+			if a[r]>>uint(c)&1 != b[c]>>uint(r)&1 {
+				t.Fatalf("bit %d of row %d was not moved to bit %d of row %d", c, r, r, c)
+			}
+		}
+	}
+}
+
+func TestCompressBatch(t *testing.T) {
+	for _, dims := range [][2]int{{8, 1024}, {2, 200}, {14, 14 * 64 * 2}, {3, 1000}} {
+		A, err := RandomMatrix(rand.Reader, dims[0], dims[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, count := range []int{0, 1, 5, 63, 64, 65, 130} {
+			t.Run(fmt.Sprintf("%dx%d/%d", dims[0], dims[1], count), func(t *testing.T) {
+				msgs := make([][]byte, count)
+				dst := make([][]byte, count)
+				for i := range msgs {
+					msgs[i] = make([]byte, A.InputLen())
+					rand.Read(msgs[i])
+					dst[i] = make([]byte, A.OutputLen())
+				}
+				if count > 2 {
+					msgs[1] = bytes.Repeat([]byte{0xff}, A.InputLen())
+				}
+
+				A.CompressBatch(dst, msgs)
+				want := make([]byte, A.OutputLen())
+				for i, msg := range msgs {
+					A.Compress(want, msg)
+					if !bytes.Equal(dst[i], want) {
+						t.Errorf("message %d: got %x, want %x", i, dst[i], want)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestCompressBatchAccessPattern(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := func(fill func(msg []byte)) ([][]byte, [][]byte) {
+		msgs := make([][]byte, 70)
+		dst := make([][]byte, len(msgs))
+		for i := range msgs {
+			msgs[i] = make([]byte, A.InputLen())
+			fill(msgs[i])
+			dst[i] = make([]byte, A.OutputLen())
+		}
+		return dst, msgs
+	}
+
+	dst, zeros := batch(func([]byte) {})
+	want := accessTrace(t, func() { A.CompressBatch(dst, zeros) })
+	for i := 0; i < 5; i++ {
+		dst, msgs := batch(func(msg []byte) { rand.Read(msg) })
+		trace := accessTrace(t, func() { A.CompressBatch(dst, msgs) })
+		if !reflect.DeepEqual(trace, want) {
+			t.Fatalf("memory access pattern depends on the input")
+		}
+	}
+}
+
+func BenchmarkCompressBatch(b *testing.B) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		b.Fatal(err)
+	}
+	At := A.LookupTable()
+	msgs := make([][]byte, 64)
+	dst := make([][]byte, len(msgs))
+	for i := range msgs {
+		msgs[i] = make([]byte, A.InputLen())
+		rand.Read(msgs[i])
+		dst[i] = make([]byte, A.OutputLen())
+	}
+
+	b.Run("CompressBatch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			A.CompressBatch(dst, msgs)
+		}
+	})
+	b.Run("Matrix", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range msgs {
+				A.Compress(dst[j], msgs[j])
+			}
+		}
+	})
+	b.Run("LookupTable", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := range msgs {
+				At.Compress(dst[j], msgs[j])
+			}
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 
 	"golang.org/x/crypto/sha3"
 )
@@ -21,8 +22,11 @@ type LookupTable [][][256]uint64
 // as an 8-bit string in LE/LSB encoding, consistent with SHA-3 (NIST FIPS 202,
 // Appendix B). See also: https://keccak.team/keccak_bits_and_bytes.html
 func RandomMatrix(rand io.Reader, n int, m int) (Matrix, error) {
+	if n <= 0 || m <= 0 {
+		return nil, fmt.Errorf("%w: n=%d and m=%d must be positive", ErrBadDimensions, n, m)
+	}
 	if m%8 != 0 {
-		panic(fmt.Errorf("m=%d is not a multiple of 8", m))
+		return nil, fmt.Errorf("%w: m=%d is not a multiple of 8", ErrBadDimensions, m)
 	}
 
 	A := make([][]uint64, n)
@@ -44,6 +48,10 @@ func RandomMatrix(rand io.Reader, n int, m int) (Matrix, error) {
 // sumhash function using the seed bytes. n and m are the rows and columns of
 // the matrix respectively.
 func RandomMatrixFromSeed(seed []byte, n int, m int) (Matrix, error) {
+	if n > math.MaxUint16 || m > math.MaxUint16 {
+		return nil, fmt.Errorf("%w: n=%d and m=%d must fit in 16 bits", ErrBadDimensions, n, m)
+	}
+
 	xof := sha3.NewShake256()
 	// SHAKE treats bytes as LSB-first 8-bit strings, so this conforms to the sumhash spec.
 	binary.Write(xof, binary.LittleEndian, uint16(64)) // u=64
@@ -54,6 +62,42 @@ func RandomMatrixFromSeed(seed []byte, n int, m int) (Matrix, error) {
 	return RandomMatrix(xof, n, m)
 }
 
+func (A Matrix) validate() error {
+	if len(A) == 0 || len(A[0]) == 0 || len(A[0])%8 != 0 {
+		return fmt.Errorf("%w: matrix must be non-empty with a multiple of 8 columns", ErrBadDimensions)
+	}
+	for i := range A {
+		if len(A[i]) != len(A[0]) {
+			return fmt.Errorf("%w: matrix row %d has %d columns, expected %d", ErrBadDimensions, i, len(A[i]), len(A[0]))
+		}
+	}
+	return nil
+}
+
+func (A LookupTable) validate() error {
+	if len(A) == 0 || len(A[0]) == 0 {
+		return fmt.Errorf("%w: lookup table must be non-empty", ErrBadDimensions)
+	}
+	for i := range A {
+		if len(A[i]) != len(A[0]) {
+			return fmt.Errorf("%w: lookup table row %d has %d entries, expected %d", ErrBadDimensions, i, len(A[i]), len(A[0]))
+		}
+	}
+	return nil
+}
+
+func (A NibbleTable) validate() error {
+	if len(A) == 0 || len(A[0]) == 0 || len(A[0])%2 != 0 {
+		return fmt.Errorf("%w: nibble table must be non-empty with an even number of entries", ErrBadDimensions)
+	}
+	for i := range A {
+		if len(A[i]) != len(A[0]) {
+			return fmt.Errorf("%w: nibble table row %d has %d entries, expected %d", ErrBadDimensions, i, len(A[i]), len(A[0]))
+		}
+	}
+	return nil
+}
+
 // LookupTable generates a lookup table used to increase hash calculation performance.
 func (A Matrix) LookupTable() LookupTable {
 	n := len(A)
@@ -71,6 +115,57 @@ func (A Matrix) LookupTable() LookupTable {
 	return At
 }
 
+// InterleavedLookupTable holds the same precomputed sums as LookupTable, laid
+// out with dimensions [m/8][256][n]uint64. The n sums selected by a byte of
+// input are contiguous, so each byte of input is looked up once instead of n
+// times in distant parts of the table.
+type InterleavedLookupTable struct {
+	n    int      // number of rows of the matrix
+	sums []uint64 // the table, flattened
+}
+
+// InterleavedLookupTable generates a row-interleaved lookup table.
+func (A Matrix) InterleavedLookupTable() InterleavedLookupTable {
+	n := len(A)
+	m := len(A[0])
+	T := InterleavedLookupTable{n: n, sums: make([]uint64, m/8*256*n)}
+	for j := 0; j < m; j += 8 {
+		for b := 0; b < 256; b++ {
+			e := T.sums[(j/8*256+b)*n:][:n]
+			for i := range A {
+				e[i] = sumBits(A[i][j:j+8], byte(b))
+			}
+		}
+	}
+	return T
+}
+
+// NibbleTable is the precomputed sums from a matrix for every possible 4-bit
+// nibble of input. Its dimensions are [n][m/4][16]uint64, which makes it 8
+// times smaller than a LookupTable, at the cost of twice as many lookups.
+type NibbleTable [][][16]uint64
+
+// NibbleTable generates a nibble-based lookup table.
+func (A Matrix) NibbleTable() NibbleTable {
+	n := len(A)
+	m := len(A[0])
+	At := make(NibbleTable, n)
+	for i := range A {
+		At[i] = make([][16]uint64, m/4)
+
+		for j := 0; j < m; j += 4 {
+			for b := 0; b < 16; b++ {
+				var x uint64
+				for k := 0; k < 4; k++ {
+					x += A[i][j+k] & -uint64((b>>k)&1)
+				}
+				At[i][j/4][b] = x
+			}
+		}
+	}
+	return At
+}
+
 func sumBits(as []uint64, b byte) uint64 {
 	//the following code is an optimization for this loop
 	//	for i := 0; i < 8; i++ {
@@ -109,7 +204,9 @@ func (A Matrix) InputLen() int {
 // OutputLen returns the output len in bytes of the compression function
 func (A Matrix) OutputLen() int { return len(A) * 8 }
 
-// Compress performs the compression algorithm on a message and output into dst
+// Compress performs the compression algorithm on a message and output into dst.
+// It runs in constant time: the memory it accesses and its branches do not
+// depend on the message.
 func (A Matrix) Compress(dst []byte, msg []byte) {
 	if len(msg) != A.InputLen() {
 		panic(fmt.Errorf("could not compress message. input size is wrong. size is %d, expected %d", len(msg), A.InputLen()))
@@ -126,6 +223,9 @@ func (A Matrix) Compress(dst []byte, msg []byte) {
 	for i := range A {
 		x = 0
 		for j := range msg {
+			if traceAccess && traceMatrixAccess != nil {
+				traceMatrixAccess(i, 8*j)
+			}
 			//the following code is an optimization for this loop
 			//			for b := 0; b < 8; b++ {
 			//					if (msg[j]>>b)&1 == 1 {
@@ -165,6 +265,12 @@ func (A LookupTable) Compress(dst []byte, msg []byte) {
 		panic(fmt.Errorf("could not compress message. output size is wrong size is %d, expected %d", len(dst), A.OutputLen()))
 	}
 
+	lookupTableCompress(A, dst, msg)
+}
+
+// lookupTableCompressGeneric is the pure Go implementation of
+// LookupTable.Compress. Some architectures provide a faster one.
+func lookupTableCompressGeneric(A LookupTable, dst []byte, msg []byte) {
 	// this allows go to eliminate the bound check when accessing the slice
 	_ = msg[A.InputLen()-1]
 	_ = dst[A.OutputLen()-1]
@@ -178,3 +284,181 @@ func (A LookupTable) Compress(dst []byte, msg []byte) {
 		binary.LittleEndian.PutUint64(dst[8*i:8*i+8], x)
 	}
 }
+
+// fingerprint identifies the matrix of a compressor. It is the SHAKE256 digest
+// of the dimensions of the matrix and of all its elements, so a matrix and the
+// lookup tables built from it have the same fingerprint. The matrix of a lookup
+// table is read from its entries for inputs with a single bit set. That of any
+// other compressor is recovered by compressing each such input, which assumes
+// that the compressor is linear.
+func fingerprint(c Compressor) (fp [32]byte) {
+	switch c.(type) {
+	case lazyCompressor, sumhash512ConstantTime:
+		return sumhash512Description().Fingerprint
+	}
+	n := c.OutputLen() / 8
+	m := 8 * c.InputLen()
+	elem := matrixElements(c)
+
+	xof := sha3.NewShake256()
+	xof.Write([]byte("sumhash fingerprint"))
+	var buf [512]byte
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(n))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(m))
+	xof.Write(buf[:8])
+	k := 0
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			binary.LittleEndian.PutUint64(buf[k:k+8], elem(i, j))
+			k += 8
+			if k == len(buf) {
+				xof.Write(buf[:])
+				k = 0
+			}
+		}
+	}
+	xof.Write(buf[:k])
+	xof.Read(fp[:])
+	return fp
+}
+
+// matrixElements returns a function which gives the element in row i and
+// column j of the matrix of c.
+func matrixElements(c Compressor) func(i, j int) uint64 {
+	switch T := c.(type) {
+	case Matrix:
+		return func(i, j int) uint64 { return T[i][j] }
+	case LookupTable:
+		return func(i, j int) uint64 { return T[i][j/8][1<<(j%8)] }
+	case NibbleTable:
+		return func(i, j int) uint64 { return T[i][j/4][1<<(j%4)] }
+	case InterleavedLookupTable:
+		return func(i, j int) uint64 { return T.sums[(j/8*256+1<<(j%8))*T.n+i] }
+	case *MappedTable:
+		return matrixElements(T.table)
+	}
+
+	// Compressing the input with only bit j set gives column j.
+	A := make(Matrix, c.OutputLen()/8)
+	for i := range A {
+		A[i] = make([]uint64, 8*c.InputLen())
+	}
+	msg := make([]byte, c.InputLen())
+	out := make([]byte, c.OutputLen())
+	for j := range A[0] {
+		msg[j/8] = 1 << (j % 8)
+		c.Compress(out, msg)
+		msg[j/8] = 0
+		for i := range A {
+			A[i][j] = binary.LittleEndian.Uint64(out[8*i:])
+		}
+	}
+	return func(i, j int) uint64 { return A[i][j] }
+}
+
+// InputLen returns the valid length of a message in bytes
+func (T InterleavedLookupTable) InputLen() int {
+	if T.n == 0 {
+		return 0
+	}
+	return len(T.sums) / (256 * T.n)
+}
+
+// OutputLen returns the output len in bytes of the compression function
+func (T InterleavedLookupTable) OutputLen() int {
+	return T.n * 8
+}
+
+// Compress performs the compression algorithm on a message and output into dst
+func (T InterleavedLookupTable) Compress(dst []byte, msg []byte) {
+	if len(msg) != T.InputLen() {
+		panic(fmt.Errorf("could not compress message. input size is wrong. size is %d, expected %d", len(msg), T.InputLen()))
+	}
+	if len(dst) != T.OutputLen() {
+		panic(fmt.Errorf("could not compress message. output size is wrong size is %d, expected %d", len(dst), T.OutputLen()))
+	}
+
+	if T.n == 8 {
+		T.compress8(dst, msg)
+		return
+	}
+
+	var buf [16]uint64
+	var acc []uint64
+	if T.n <= len(buf) {
+		acc = buf[:T.n]
+	} else {
+		acc = make([]uint64, T.n)
+	}
+	for j, b := range msg {
+		e := T.sums[(j*256+int(b))*T.n:]
+		e = e[:len(acc)]
+		for i := range acc {
+			acc[i] += e[i]
+		}
+	}
+	for i, x := range acc {
+		binary.LittleEndian.PutUint64(dst[8*i:8*i+8], x)
+	}
+}
+
+// compress8 is Compress for tables with 8 rows, such as the one of sumhash512.
+// It keeps the accumulators in registers.
+func (T InterleavedLookupTable) compress8(dst []byte, msg []byte) {
+	var x0, x1, x2, x3, x4, x5, x6, x7 uint64
+	for j, b := range msg {
+		e := T.sums[(j*256+int(b))*8:]
+		e = e[:8]
+		x0 += e[0]
+		x1 += e[1]
+		x2 += e[2]
+		x3 += e[3]
+		x4 += e[4]
+		x5 += e[5]
+		x6 += e[6]
+		x7 += e[7]
+	}
+	_ = dst[63]
+	binary.LittleEndian.PutUint64(dst[0:8], x0)
+	binary.LittleEndian.PutUint64(dst[8:16], x1)
+	binary.LittleEndian.PutUint64(dst[16:24], x2)
+	binary.LittleEndian.PutUint64(dst[24:32], x3)
+	binary.LittleEndian.PutUint64(dst[32:40], x4)
+	binary.LittleEndian.PutUint64(dst[40:48], x5)
+	binary.LittleEndian.PutUint64(dst[48:56], x6)
+	binary.LittleEndian.PutUint64(dst[56:64], x7)
+}
+
+// InputLen returns the valid length of a message in bytes
+func (A NibbleTable) InputLen() int {
+	return len(A[0]) / 2
+}
+
+// OutputLen returns the output len in bytes of the compression function
+func (A NibbleTable) OutputLen() int {
+	return len(A) * 8
+}
+
+// Compress performs the compression algorithm on a message and output into dst
+func (A NibbleTable) Compress(dst []byte, msg []byte) {
+	if len(msg) != A.InputLen() {
+		panic(fmt.Errorf("could not compress message. input size is wrong. size is %d, expected %d", len(msg), A.InputLen()))
+	}
+	if len(dst) != A.OutputLen() {
+		panic(fmt.Errorf("could not compress message. output size is wrong size is %d, expected %d", len(dst), A.OutputLen()))
+	}
+
+	// this allows go to eliminate the bound check when accessing the slice
+	_ = msg[A.InputLen()-1]
+	_ = dst[A.OutputLen()-1]
+
+	var x uint64
+	for i := range A {
+		x = 0
+		row := A[i][:2*len(msg)]
+		for j, b := range msg {
+			x += row[2*j][b&0x0f] + row[2*j+1][b>>4]
+		}
+		binary.LittleEndian.PutUint64(dst[8*i:8*i+8], x)
+	}
+}
@@ -0,0 +1,70 @@
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+package sumhash
+
+import (
+	"encoding/binary"
+
+	"golang.org/x/sys/cpu"
+)
+
+var useAVX2 = cpu.X86.HasAVX2
+
+// lookupRowSumAVX2 returns the sum of row[j][msg[j]] for j < n, using AVX2
+// gathers. n must be a multiple of 8.
+//
+//go:noescape
+func lookupRowSumAVX2(row *[256]uint64, msg *byte, n int) uint64
+
+func lookupTableCompress(A LookupTable, dst []byte, msg []byte) {
+	if !useAVX2 {
+		lookupTableCompressGeneric(A, dst, msg)
+		return
+	}
+
+	n := len(msg) &^ 7
+	for i := range A {
+		x := lookupRowSumAVX2(&A[i][0], &msg[0], n)
+		for j := n; j < len(msg); j++ {
+			x += A[i][j][msg[j]]
+		}
+		binary.LittleEndian.PutUint64(dst[8*i:8*i+8], x)
+	}
+}
+
+// lookupRowSum4AVX2 stores in sums[k] the sum of row[j][mk[j]] for j < n, for
+// the four messages m0 to m3. Each AVX2 gather loads the entries of one column
+// for the four messages. n must be a multiple of 4.
+//
+//go:noescape
+func lookupRowSum4AVX2(row *[256]uint64, m0, m1, m2, m3 *byte, n int, sums *[4]uint64)
+
+func lookupTableCompress4(A LookupTable, lanes []batchLane) {
+	if !useAVX2 {
+		lookupTableCompress4Generic(A, lanes)
+		return
+	}
+
+	lanes = lanes[:4]
+	m0, m1, m2, m3 := lanes[0].cin, lanes[1].cin, lanes[2].cin, lanes[3].cin
+	n := A.InputLen()
+	_, _, _, _ = m0[n-1], m1[n-1], m2[n-1], m3[n-1]
+	n4 := n &^ 3
+
+	var sums [4]uint64
+	for i := range A {
+		row := A[i][:n]
+		lookupRowSum4AVX2(&row[0], &m0[0], &m1[0], &m2[0], &m3[0], n4, &sums)
+		for j := n4; j < n; j++ {
+			col := &row[j]
+			sums[0] += col[m0[j]]
+			sums[1] += col[m1[j]]
+			sums[2] += col[m2[j]]
+			sums[3] += col[m3[j]]
+		}
+		for k, x := range sums {
+			binary.LittleEndian.PutUint64(lanes[k].h[8*i:], x)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+//go:build amd64 && !purego
+// +build amd64,!purego
+
+#include "textflag.h"
+
+// Offsets of the [256]uint64 entries of four consecutive bytes, in words.
+DATA rowOffsets<>+0x00(SB)/4, $0
+DATA rowOffsets<>+0x04(SB)/4, $256
+DATA rowOffsets<>+0x08(SB)/4, $512
+DATA rowOffsets<>+0x0c(SB)/4, $768
+GLOBL rowOffsets<>(SB), RODATA|NOPTR, $16
+
+DATA rowStep<>+0x00(SB)/4, $1024
+DATA rowStep<>+0x04(SB)/4, $1024
+DATA rowStep<>+0x08(SB)/4, $1024
+DATA rowStep<>+0x0c(SB)/4, $1024
+GLOBL rowStep<>(SB), RODATA|NOPTR, $16
+
+// func lookupRowSumAVX2(row *[256]uint64, msg *byte, n int) uint64
+// Requires: AVX2. n must be a multiple of 8.
+TEXT ·lookupRowSumAVX2(SB), NOSPLIT, $0-32
+	MOVQ row+0(FP), DI
+	MOVQ msg+8(FP), SI
+	MOVQ n+16(FP), CX
+
+	VPXOR   Y0, Y0, Y0                 // accumulator for bytes 0..3 of each group
+	VPXOR   Y4, Y4, Y4                 // accumulator for bytes 4..7 of each group
+	VMOVDQU rowOffsets<>(SB), X6       // word offsets of bytes 0..3
+	VMOVDQU rowStep<>(SB), X7          // 4 bytes, in words
+	VPADDD  X7, X6, X8                 // word offsets of bytes 4..7
+	VPADDD  X7, X7, X7                 // 8 bytes, in words
+
+	SHRQ $3, CX
+	JZ   done
+
+loop:
+	VPMOVZXBD (SI), X1
+	VPMOVZXBD 4(SI), X5
+	VPADDD    X6, X1, X1
+	VPADDD    X8, X5, X5
+	VPCMPEQD  Y3, Y3, Y3
+	VPCMPEQD  Y9, Y9, Y9
+	VPGATHERDQ Y3, (DI)(X1*8), Y2
+	VPGATHERDQ Y9, (DI)(X5*8), Y10
+	VPADDQ    Y2, Y0, Y0
+	VPADDQ    Y10, Y4, Y4
+	VPADDD    X7, X6, X6
+	VPADDD    X7, X8, X8
+	ADDQ      $8, SI
+	DECQ      CX
+	JNZ       loop
+
+done:
+	VPADDQ       Y4, Y0, Y0
+	VEXTRACTI128 $1, Y0, X1
+	VPADDQ       X1, X0, X0
+	VPSHUFD      $0x4e, X0, X1
+	VPADDQ       X1, X0, X0
+	VMOVQ        X0, AX
+	VZEROUPPER
+	MOVQ         AX, ret+24(FP)
+	RET
+
+// Transposes the 4x4 bytes of four dwords: byte 4*k+j goes to 4*j+k.
+DATA transpose4x4<>+0x00(SB)/4, $0x0c080400
+DATA transpose4x4<>+0x04(SB)/4, $0x0d090501
+DATA transpose4x4<>+0x08(SB)/4, $0x0e0a0602
+DATA transpose4x4<>+0x0c(SB)/4, $0x0f0b0703
+GLOBL transpose4x4<>(SB), RODATA|NOPTR, $16
+
+// func lookupRowSum4AVX2(row *[256]uint64, m0, m1, m2, m3 *byte, n int, sums *[4]uint64)
+// Requires: AVX2. n must be a multiple of 4.
+TEXT ·lookupRowSum4AVX2(SB), NOSPLIT, $0-56
+	MOVQ row+0(FP), DI
+	MOVQ m0+8(FP), R8
+	MOVQ m1+16(FP), R9
+	MOVQ m2+24(FP), R10
+	MOVQ m3+32(FP), R11
+	MOVQ n+40(FP), CX
+
+	VPXOR   Y0, Y0, Y0           // accumulators of the four messages
+	VPXOR   Y1, Y1, Y1           // second set, for independent additions
+	VMOVDQU transpose4x4<>(SB), X7
+
+	SHRQ $2, CX
+	JZ   done
+
+loop:
+	// Bytes j..j+3 of the four messages, transposed so that each dword
+	// holds byte j+k of the four messages.
+	VMOVD     (R8), X2
+	VPINSRD   $1, (R9), X2, X2
+	VPINSRD   $2, (R10), X2, X2
+	VPINSRD   $3, (R11), X2, X2
+	VPSHUFB   X7, X2, X2
+	VPMOVZXBD X2, X3
+	VPSRLDQ   $4, X2, X4
+	VPMOVZXBD X4, X4
+	VPSRLDQ   $8, X2, X5
+	VPMOVZXBD X5, X5
+	VPSRLDQ   $12, X2, X6
+	VPMOVZXBD X6, X6
+
+	// One gather per column, of the entries selected by the four messages.
+	VPCMPEQD   Y8, Y8, Y8
+	VPCMPEQD   Y13, Y13, Y13
+	VPCMPEQD   Y14, Y14, Y14
+	VPCMPEQD   Y15, Y15, Y15
+	VPGATHERDQ Y8, (DI)(X3*8), Y9
+	VPGATHERDQ Y13, 2048(DI)(X4*8), Y10
+	VPGATHERDQ Y14, 4096(DI)(X5*8), Y11
+	VPGATHERDQ Y15, 6144(DI)(X6*8), Y12
+	VPADDQ     Y9, Y0, Y0
+	VPADDQ     Y10, Y1, Y1
+	VPADDQ     Y11, Y0, Y0
+	VPADDQ     Y12, Y1, Y1
+
+	ADDQ $8192, DI
+	ADDQ $4, R8
+	ADDQ $4, R9
+	ADDQ $4, R10
+	ADDQ $4, R11
+	DECQ CX
+	JNZ  loop
+
+done:
+	VPADDQ  Y1, Y0, Y0
+	MOVQ    sums+48(FP), AX
+	VMOVDQU Y0, (AX)
+	VZEROUPPER
+	RET
@@ -0,0 +1,14 @@
+//go:build !amd64 || purego
+// +build !amd64 purego
+
+package sumhash
+
+var useAVX2 = false
+
+func lookupTableCompress(A LookupTable, dst []byte, msg []byte) {
+	lookupTableCompressGeneric(A, dst, msg)
+}
+
+func lookupTableCompress4(A LookupTable, lanes []batchLane) {
+	lookupTableCompress4Generic(A, lanes)
+}
@@ -2,6 +2,7 @@ package sumhash
 
 import (
 	"crypto/rand"
+	"errors"
 
 	"reflect"
 	"testing"
@@ -93,3 +94,153 @@ func BenchmarkCreateLookupTable(b *testing.B) {
 	}
 
 }
+
+func TestRandomMatrixBadDimensions(t *testing.T) {
+	for _, dims := range [][2]int{{0, 1024}, {8, 0}, {8, 1020}, {-8, 1024}} {
+		_, err := RandomMatrix(rand.Reader, dims[0], dims[1])
+		if !errors.Is(err, ErrBadDimensions) {
+			t.Errorf("n=%d m=%d: got error %v, want %v", dims[0], dims[1], err, ErrBadDimensions)
+		}
+	}
+}
+
+func TestLookupTableCompressAVX2(t *testing.T) {
+	if !useAVX2 {
+		t.Skip("AVX2 is not available")
+	}
+
+	for _, dims := range [][2]int{{8, 1024}, {14, 14 * 64 * 2}, {2, 200}, {3, 8 * 61}} {
+		A, err := RandomMatrix(rand.Reader, dims[0], dims[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		At := A.LookupTable()
+
+		dst1 := make([]byte, A.OutputLen())
+		dst2 := make([]byte, A.OutputLen())
+		dst3 := make([]byte, A.OutputLen())
+		msg := make([]byte, A.InputLen())
+		for i := 0; i < 1000; i++ {
+			rand.Read(msg)
+			A.Compress(dst1, msg)
+			At.Compress(dst2, msg)
+			lookupTableCompressGeneric(At, dst3, msg)
+			if !reflect.DeepEqual(dst1, dst2) || !reflect.DeepEqual(dst1, dst3) {
+				t.Fatalf("compressed outputs differ (n=%d, m=%d)", dims[0], dims[1])
+			}
+		}
+	}
+}
+
+func BenchmarkLookupTableGeneric(b *testing.B) {
+	defer func(saved bool) { useAVX2 = saved }(useAVX2)
+	useAVX2 = false
+	BenchmarkLookupTable(b)
+}
+
+func TestInterleavedLookupTable(t *testing.T) {
+	for _, dims := range [][2]int{{8, 1024}, {14, 14 * 64 * 2}, {2, 200}, {20, 20 * 64 * 2}} {
+		A, err := RandomMatrix(rand.Reader, dims[0], dims[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		Ai := A.InterleavedLookupTable()
+
+		if Ai.InputLen() != A.InputLen() || Ai.OutputLen() != A.OutputLen() {
+			t.Errorf("unexpected dimensions: got %d, %d, want %d, %d", Ai.InputLen(), Ai.OutputLen(), A.InputLen(), A.OutputLen())
+		}
+
+		dst1 := make([]byte, A.OutputLen())
+		dst2 := make([]byte, A.OutputLen())
+		msg := make([]byte, A.InputLen())
+		for i := 0; i < 1000; i++ {
+			rand.Read(msg)
+			A.Compress(dst1, msg)
+			Ai.Compress(dst2, msg)
+			if !reflect.DeepEqual(dst1, dst2) {
+				t.Fatalf("compressed outputs differ (n=%d, m=%d)", dims[0], dims[1])
+			}
+		}
+	}
+}
+
+func BenchmarkInterleavedLookupTable(b *testing.B) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		b.Error(err)
+	}
+
+	Ai := A.InterleavedLookupTable()
+
+	msg := make([]byte, A.InputLen())
+	dst := make([]byte, A.OutputLen())
+	rand.Read(msg)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		Ai.Compress(dst, msg)
+		copy(msg[0:64], msg[64:128])
+		copy(msg[64:128], dst)
+	}
+}
+
+func TestNibbleTable(t *testing.T) {
+	for _, dims := range [][2]int{{8, 1024}, {14, 14 * 64 * 2}, {2, 200}} {
+		A, err := RandomMatrix(rand.Reader, dims[0], dims[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		At := A.LookupTable()
+		An := A.NibbleTable()
+
+		if An.InputLen() != A.InputLen() || An.OutputLen() != A.OutputLen() {
+			t.Errorf("unexpected dimensions: got %d, %d, want %d, %d", An.InputLen(), An.OutputLen(), A.InputLen(), A.OutputLen())
+		}
+
+		dst1 := make([]byte, A.OutputLen())
+		dst2 := make([]byte, A.OutputLen())
+		dst3 := make([]byte, A.OutputLen())
+		msg := make([]byte, A.InputLen())
+		for i := 0; i < 1000; i++ {
+			rand.Read(msg)
+			A.Compress(dst1, msg)
+			At.Compress(dst2, msg)
+			An.Compress(dst3, msg)
+			if !reflect.DeepEqual(dst1, dst2) || !reflect.DeepEqual(dst1, dst3) {
+				t.Fatalf("compressed outputs differ (n=%d, m=%d)", dims[0], dims[1])
+			}
+		}
+	}
+}
+
+func BenchmarkNibbleTable(b *testing.B) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		b.Error(err)
+	}
+
+	An := A.NibbleTable()
+
+	msg := make([]byte, A.InputLen())
+	dst := make([]byte, A.OutputLen())
+	rand.Read(msg)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		An.Compress(dst, msg)
+		copy(msg[0:64], msg[64:128])
+		copy(msg[64:128], dst)
+	}
+}
+
+func BenchmarkCreateNibbleTable(b *testing.B) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		b.Error(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = A.NibbleTable()
+	}
+}
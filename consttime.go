@@ -0,0 +1,71 @@
+package sumhash
+
+import (
+	"hash"
+)
+
+// ConstantTime reports whether the compressor runs in constant time, that is,
+// whether the memory it accesses and its branches do not depend on its input.
+// This is the case of Matrix, whose Compress and CompressBatch select the
+// elements with masks derived from the bits of the input, and never branch or
+// index memory on them. The tests check it when built with the sumhash_trace
+// tag, which records the elements read; see traceMatrixAccess. The lookup
+// tables index memory with the bytes of the input, so their cache access
+// pattern leaks it.
+//
+// Other compressors may declare that they run in constant time by having a
+// ConstantTime() bool method.
+func ConstantTime(c Compressor) bool {
+	ct, ok := c.(interface{ ConstantTime() bool })
+	return ok && ct.ConstantTime()
+}
+
+// ConstantTime reports that Matrix.Compress runs in constant time.
+func (A Matrix) ConstantTime() bool {
+	return true
+}
+
+// New512ConstantTime is like New512, but the returned hash.Hash runs in
+// constant time, so that it can process secret salts and messages without
+// leaking them through its memory access pattern. It uses the sumhash512
+// matrix instead of its lookup table, which makes it several times slower.
+// Only the lengths of the salt and of the input are not hidden.
+func New512ConstantTime(salt []byte) hash.Hash {
+	return New(sumhash512ConstantTime{sumhash512Matrix()}, salt)
+}
+
+// sumhash512ConstantTime is the compressor of New512ConstantTime. It wraps the
+// sumhash512 matrix, whose rows are shared by the whole package, so that
+// Digest.Compressor does not hand them out for modification.
+type sumhash512ConstantTime struct {
+	a Matrix
+}
+
+func (c sumhash512ConstantTime) Compress(dst []byte, msg []byte) {
+	c.a.Compress(dst, msg)
+}
+
+func (c sumhash512ConstantTime) InputLen() int {
+	return c.a.InputLen()
+}
+
+func (c sumhash512ConstantTime) OutputLen() int {
+	return c.a.OutputLen()
+}
+
+// ConstantTime reports that the compressor of New512ConstantTime runs in
+// constant time.
+func (sumhash512ConstantTime) ConstantTime() bool {
+	return true
+}
+
+// Describe returns the parameters of the sumhash512 instance.
+func (sumhash512ConstantTime) Describe() Description {
+	return sumhash512Description()
+}
+
+// traceMatrixAccess, if set, is called by Matrix.Compress and
+// Matrix.CompressBatch before they read the elements of row from col, in
+// builds with the sumhash_trace tag. It allows tests to check that which
+// elements are read, and in which order, does not depend on the input.
+var traceMatrixAccess func(row, col int)
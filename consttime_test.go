@@ -0,0 +1,132 @@
+package sumhash
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// accessTrace records the memory accesses of Matrix.Compress and
+// Matrix.CompressBatch while f runs. The test is skipped unless the package is
+// built with the sumhash_trace tag:
+//
+//	go test -tags sumhash_trace
+func accessTrace(t *testing.T, f func()) [][2]int {
+	if !traceAccess {
+		t.Skip("memory accesses are only traced with the sumhash_trace build tag")
+	}
+	var trace [][2]int
+	traceMatrixAccess = func(row, col int) {
+		trace = append(trace, [2]int{row, col})
+	}
+	defer func() { traceMatrixAccess = nil }()
+
+	f()
+	return trace
+}
+
+func TestConstantTime(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ConstantTime(A) {
+		t.Errorf("Matrix is not reported as constant time")
+	}
+	for _, c := range []Compressor{A.LookupTable(), A.InterleavedLookupTable(), A.NibbleTable(), SumhashCompressor} {
+		if ConstantTime(c) {
+			t.Errorf("%T is reported as constant time", c)
+		}
+	}
+}
+
+func TestMatrixAccessPattern(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := make([]byte, A.OutputLen())
+
+	zeros := make([]byte, A.InputLen())
+	ones := bytes.Repeat([]byte{0xff}, A.InputLen())
+	want := accessTrace(t, func() { A.Compress(dst, zeros) })
+	if len(want) != A.InputLen()*len(A) {
+		t.Fatalf("unexpected trace length: got %d, want %d", len(want), A.InputLen()*len(A))
+	}
+
+	inputs := [][]byte{ones}
+	for i := 0; i < 20; i++ {
+		msg := make([]byte, A.InputLen())
+		rand.Read(msg)
+		inputs = append(inputs, msg)
+	}
+	for _, msg := range inputs {
+		trace := accessTrace(t, func() { A.Compress(dst, msg) })
+		if !reflect.DeepEqual(trace, want) {
+			t.Fatalf("memory access pattern depends on the input %x", msg)
+		}
+	}
+}
+
+func TestNew512ConstantTime(t *testing.T) {
+	for i, element := range testVector {
+		h := New512ConstantTime(nil)
+		io.WriteString(h, element.input)
+		if output := hex.EncodeToString(h.Sum(nil)); output != element.output {
+			t.Errorf("test vector element mismatched on index %d failed! got %s, want %s", i, output, element.output)
+		}
+	}
+
+	msg := make([]byte, 300)
+	salt := make([]byte, 64)
+	for i := 0; i < 10; i++ {
+		rand.Read(msg)
+		rand.Read(salt)
+
+		h := New512ConstantTime(salt)
+		h.Write(msg)
+		sum := h.Sum(nil)
+
+		h = New512(salt)
+		h.Write(msg)
+		if !bytes.Equal(sum, h.Sum(nil)) {
+			t.Errorf("constant time hash differs from New512")
+		}
+	}
+
+	c := New512ConstantTime(nil).(*Digest).Compressor()
+	if _, ok := c.(Matrix); ok {
+		t.Fatalf("New512ConstantTime exposes the sumhash512 matrix")
+	}
+	if d, ok := Describe(c); !ConstantTime(c) || !ok || d.Name != "sumhash512" {
+		t.Errorf("unexpected compressor of New512ConstantTime: constant time %v, description %+v", ConstantTime(c), d)
+	}
+}
+
+func TestNew512ConstantTimeAccessPattern(t *testing.T) {
+	msg := make([]byte, 300)
+	salt := make([]byte, 64)
+	var want [][2]int
+	for i := 0; i < 10; i++ {
+		rand.Read(msg)
+		rand.Read(salt)
+
+		trace := accessTrace(t, func() {
+			h := New512ConstantTime(salt)
+			h.Write(msg)
+			h.Sum(nil)
+		})
+		if i == 0 {
+			want = trace
+		} else if !reflect.DeepEqual(trace, want) {
+			t.Fatalf("memory access pattern depends on the salt or the input")
+		}
+	}
+	if len(want) == 0 {
+		t.Fatalf("no memory access traced")
+	}
+}
@@ -0,0 +1,140 @@
+package sumhash
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// Description holds the parameters of a sumhash compressor.
+type Description struct {
+	N           int // number of rows of the matrix, OutputLen()/8
+	M           int // number of columns of the matrix, 8*InputLen()
+	ModulusBits int // the elements of the matrix are integers modulo 2^ModulusBits
+
+	// Seed is the seed from which the matrix is derived by
+	// RandomMatrixFromSeed, and XOF the function which expands it. They are
+	// only set when known, for example for the sumhash512 instance.
+	Seed []byte
+	XOF  string
+
+	// Fingerprint is a hash of the dimensions and of every element of the
+	// matrix: a matrix and the lookup tables built from it have the same
+	// fingerprint.
+	Fingerprint [32]byte
+
+	// Name is the name of a well-known instance, such as "sumhash512", or
+	// empty.
+	Name string
+}
+
+// String returns the name of the instance if it is well-known, or its
+// parameters and a prefix of its fingerprint.
+func (d Description) String() string {
+	if d.Name != "" {
+		return d.Name
+	}
+	return fmt.Sprintf("sumhash(n=%d, m=%d, q=2^%d, fingerprint=%s)", d.N, d.M, d.ModulusBits, hex.EncodeToString(d.Fingerprint[:8]))
+}
+
+// Describer is implemented by compressors which can describe their
+// parameters, such as Matrix and LookupTable.
+type Describer interface {
+	Describe() Description
+}
+
+// Describe returns the description of c, if it implements Describer.
+func Describe(c Compressor) (Description, bool) {
+	if d, ok := c.(Describer); ok {
+		return d.Describe(), true
+	}
+	return Description{}, false
+}
+
+// xofShake256 is the XOF used by RandomMatrixFromSeed.
+const xofShake256 = "SHAKE256"
+
+var sumhash512Desc struct {
+	once sync.Once
+	d    Description
+}
+
+// sumhash512Description returns the description of the sumhash512 instance.
+func sumhash512Description() Description {
+	sumhash512Desc.once.Do(func() {
+		A := sumhash512Matrix()
+		sumhash512Desc.d = Description{
+			N:           len(A),
+			M:           len(A[0]),
+			ModulusBits: 64,
+			Seed:        sumhash512Seed,
+			XOF:         xofShake256,
+			Fingerprint: fingerprint(A),
+			Name:        "sumhash512",
+		}
+	})
+	d := sumhash512Desc.d
+	d.Seed = append([]byte(nil), d.Seed...)
+	return d
+}
+
+// describe returns the description of the n-by-m compressor c. It recognizes
+// the sumhash512 instance by its fingerprint.
+func describe(c Compressor, n int, m int) Description {
+	fp := fingerprint(c)
+	if sd := sumhash512Description(); fp == sd.Fingerprint && n == sd.N && m == sd.M {
+		return sd
+	}
+	return Description{N: n, M: m, ModulusBits: 64, Fingerprint: fp}
+}
+
+// Describe returns the parameters of the matrix. It must have valid
+// dimensions.
+func (A Matrix) Describe() Description {
+	return describe(A, len(A), len(A[0]))
+}
+
+// Describe returns the parameters of the matrix of the lookup table. It must
+// have valid dimensions.
+func (T LookupTable) Describe() Description {
+	return describe(T, len(T), 8*len(T[0]))
+}
+
+// Describe returns the parameters of the sumhash512 instance.
+func (lazyCompressor) Describe() Description {
+	return sumhash512Description()
+}
+
+// Describe returns the parameters of the mapped table, including the seed it
+// was checked against.
+func (t *MappedTable) Describe() Description {
+	d := t.table.Describe()
+	if d.Seed == nil {
+		d.Seed = append([]byte{}, t.seed...)
+		d.XOF = xofShake256
+	}
+	return d
+}
+
+// Description returns the description of the compressor of the Digest, if it
+// implements Describer. Together with Salted and ExtensionResistant, it
+// identifies the function which the Digest computes.
+func (d *Digest) Description() (Description, bool) {
+	return Describe(d.c)
+}
+
+// Compressor returns the compressor of the Digest.
+func (d *Digest) Compressor() Compressor {
+	return d.c
+}
+
+// Salted reports whether the Digest computes hashes in salted mode.
+func (d *Digest) Salted() bool {
+	return d.salt != nil
+}
+
+// ExtensionResistant reports whether the Digest hashes its output again, as
+// created by NewExtensionResistant.
+func (d *Digest) ExtensionResistant() bool {
+	return d.outer
+}
@@ -0,0 +1,116 @@
+package sumhash
+
+import (
+	"bytes"
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	A, err := RandomMatrixFromSeed([]byte("describe"), 4, 512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	B, err := RandomMatrix(rand.Reader, 4, 512)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	da := A.Describe()
+	if da.N != 4 || da.M != 512 || da.ModulusBits != 64 || da.Seed != nil || da.Name != "" {
+		t.Errorf("unexpected description %+v", da)
+	}
+	if dt := A.LookupTable().Describe(); dt.Fingerprint != da.Fingerprint || dt.N != da.N || dt.M != da.M {
+		t.Errorf("lookup table and matrix have different descriptions: %+v, %+v", dt, da)
+	}
+	if B.Describe().Fingerprint == da.Fingerprint {
+		t.Errorf("matrices of the same size have the same fingerprint")
+	}
+	if _, ok := Describe(A.NibbleTable()); ok {
+		t.Errorf("NibbleTable is described")
+	}
+
+	for _, c := range []Compressor{SumhashCompressor, Sumhash512Compressor(), sumhash512Matrix()} {
+		d, ok := Describe(c)
+		if !ok {
+			t.Fatalf("%T is not described", c)
+		}
+		if d.Name != "sumhash512" || d.String() != "sumhash512" || !bytes.Equal(d.Seed, []byte("Algorand")) || d.XOF != "SHAKE256" || d.N != 8 || d.M != 1024 {
+			t.Errorf("%T: unexpected description %+v", c, d)
+		}
+		d.Seed[0] = 'X'
+	}
+	if !bytes.Equal(sumhash512Seed, []byte("Algorand")) {
+		t.Fatalf("seed modified through a description")
+	}
+
+	d, ok := New512Digest(make([]byte, 64)).Description()
+	if !ok || d.Name != "sumhash512" {
+		t.Errorf("unexpected description of New512Digest: %+v", d)
+	}
+	if !New512Digest(make([]byte, 64)).Salted() || New512Digest(nil).Salted() {
+		t.Errorf("wrong salt mode")
+	}
+	if !New512ExtensionResistant(nil).(*Digest).ExtensionResistant() || New512Digest(nil).ExtensionResistant() {
+		t.Errorf("wrong extension resistant mode")
+	}
+	if s := NewDigest(A, nil).Compressor(); s.InputLen() != A.InputLen() {
+		t.Errorf("wrong compressor")
+	}
+}
+
+func TestDescribeMappedTable(t *testing.T) {
+	seed := []byte("describe")
+	path := filepath.Join(t.TempDir(), "table")
+	if err := WriteLookupTableFile(path, seed, 4, 512); err != nil {
+		t.Fatal(err)
+	}
+	mt, err := MapLookupTable(path, seed, 4, 512)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mt.Close()
+
+	A, _ := RandomMatrixFromSeed(seed, 4, 512)
+	d := mt.Describe()
+	if !bytes.Equal(d.Seed, seed) || d.XOF != "SHAKE256" || d.Fingerprint != A.Describe().Fingerprint {
+		t.Errorf("unexpected description %+v", d)
+	}
+	if d.String() != A.Describe().String() {
+		t.Errorf("got %s, want %s", d, A.Describe())
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 2, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fp := fingerprint(A)
+	for _, c := range []Compressor{A.LookupTable(), A.NibbleTable(), A.InterleavedLookupTable(), struct{ Compressor }{A}} {
+		if fingerprint(c) != fp {
+			t.Errorf("%T has another fingerprint than its matrix", c)
+		}
+	}
+
+	for i := range A {
+		for j := range A[i] {
+			A[i][j]++
+			if fingerprint(A) == fp {
+				t.Errorf("fingerprint did not change with element (%d, %d)", i, j)
+			}
+			A[i][j]--
+		}
+	}
+
+	B := sumhash512Matrix()
+	C := make(Matrix, len(B))
+	for i := range B {
+		C[i] = append([]uint64(nil), B[i]...)
+	}
+	C[7][1023] ^= 1
+	if d := C.Describe(); d.Name != "" || d.Seed != nil || d.Fingerprint == sumhash512Description().Fingerprint {
+		t.Errorf("modified sumhash512 matrix is described as %+v", d)
+	}
+}
@@ -0,0 +1,317 @@
+// Package digest implements self-describing sumhash digests, which carry the
+// identity of the function which produced them along with the 64-byte hash,
+// so that they can be stored next to the outputs of other hash functions.
+//
+// A Digest identifies the sumhash512 instance in unsalted or salted mode, or
+// a custom compressor by its fingerprint (see sumhash.Description). The salt
+// itself is not recorded. Like a multihash, the binary encoding of a Digest
+// is a prefix identifying the function, the length of the hash, and the
+// hash:
+//
+//	algorithm    1 byte
+//	fingerprint  32 bytes, for custom compressors only
+//	length       1 byte, 64
+//	hash         64 bytes
+//
+// The text encoding is the name of the function, a colon, and the hash in
+// hex, such as "sumhash512:3a5f...". The name is "sumhash512" or
+// "sumhash512-salted" for the sumhash512 instance, and "sumhash-" followed by
+// the fingerprint in hex, then "-salted" in salted mode, for a custom
+// compressor.
+package digest
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/algorand/go-sumhash"
+)
+
+// Size is the size in bytes of the hash in a Digest.
+const Size = sumhash.Sumhash512DigestSize
+
+// Algorithm identifies the function which produced a Digest.
+type Algorithm byte
+
+// Algorithms. The zero Algorithm is invalid.
+const (
+	Sumhash512       Algorithm = 1 // sumhash512, unsalted
+	Sumhash512Salted Algorithm = 2 // sumhash512, salted
+	Custom           Algorithm = 3 // custom compressor, unsalted
+	CustomSalted     Algorithm = 4 // custom compressor, salted
+)
+
+// ErrInvalid is returned when a Digest or its encoding is malformed.
+var ErrInvalid = errors.New("digest: invalid digest")
+
+// Digest is a sumhash hash, along with the identity of the function which
+// produced it. The zero Digest is invalid.
+type Digest struct {
+	alg         Algorithm
+	fingerprint [32]byte // of the compressor, for Custom and CustomSalted
+	sum         [Size]byte
+}
+
+// Sum512 returns the Digest of data under sumhash512, in unsalted mode.
+func Sum512(data []byte) Digest {
+	return sum512(nil, data)
+}
+
+// SumSalted512 returns the Digest of data under sumhash512, in salted mode.
+// salt must be 64 bytes.
+func SumSalted512(salt []byte, data []byte) Digest {
+	if len(salt) != sumhash.Sumhash512DigestBlockSize {
+		panic(fmt.Sprintf("bad salt size: want %d, got %d", sumhash.Sumhash512DigestBlockSize, len(salt)))
+	}
+	return sum512(salt, data)
+}
+
+func sum512(salt []byte, data []byte) Digest {
+	if salt != nil {
+		return Digest{alg: Sumhash512Salted, sum: sumhash.SumSalted512(salt, data)}
+	}
+	return Digest{alg: Sumhash512, sum: sumhash.Sum512(data)}
+}
+
+// FromSum512 returns the Digest holding sum, a sumhash512 output in salted
+// or unsalted mode.
+func FromSum512(sum []byte, salted bool) (Digest, error) {
+	if len(sum) != Size {
+		return Digest{}, fmt.Errorf("%w: hash of %d bytes, want %d", ErrInvalid, len(sum), Size)
+	}
+	d := Digest{alg: Sumhash512}
+	if salted {
+		d.alg = Sumhash512Salted
+	}
+	copy(d.sum[:], sum)
+	return d, nil
+}
+
+// FromHash returns the Digest of the input written so far to h. The
+// compressor of h must implement sumhash.Describer, and have a 64-byte
+// output. h must not be extension resistant, as the algorithms do not cover
+// that mode. h is not modified.
+func FromHash(h *sumhash.Digest) (Digest, error) {
+	if h.ExtensionResistant() {
+		return Digest{}, fmt.Errorf("%w: extension resistant mode has no algorithm", ErrInvalid)
+	}
+	desc, ok := h.Description()
+	if !ok {
+		return Digest{}, fmt.Errorf("%w: compressor of type %T cannot be identified", ErrInvalid, h.Compressor())
+	}
+	if h.Size() != Size {
+		return Digest{}, fmt.Errorf("%w: hash of %d bytes, want %d", ErrInvalid, h.Size(), Size)
+	}
+
+	var d Digest
+	switch {
+	case desc.Name == "sumhash512" && h.Salted():
+		d.alg = Sumhash512Salted
+	case desc.Name == "sumhash512":
+		d.alg = Sumhash512
+	case h.Salted():
+		d.alg = CustomSalted
+	default:
+		d.alg = Custom
+	}
+	if d.alg == Custom || d.alg == CustomSalted {
+		d.fingerprint = desc.Fingerprint
+	}
+	h.Sum(d.sum[:0])
+	return d, nil
+}
+
+// Algorithm returns the algorithm of the Digest.
+func (d Digest) Algorithm() Algorithm {
+	return d.alg
+}
+
+// Fingerprint returns the fingerprint of the custom compressor which
+// produced the Digest. ok is false for the sumhash512 instance.
+func (d Digest) Fingerprint() (fp [32]byte, ok bool) {
+	return d.fingerprint, d.alg == Custom || d.alg == CustomSalted
+}
+
+// Sum returns the hash.
+func (d Digest) Sum() [Size]byte {
+	return d.sum
+}
+
+// IsZero reports whether d is the zero Digest.
+func (d Digest) IsZero() bool {
+	return d.alg == 0
+}
+
+// Equal reports whether d and e are produced by the same function, and have
+// the same hash. The hashes are compared in constant time.
+func (d Digest) Equal(e Digest) bool {
+	same := subtle.ConstantTimeCompare(d.sum[:], e.sum[:])
+	same &= subtle.ConstantTimeByteEq(byte(d.alg), byte(e.alg))
+	same &= subtle.ConstantTimeCompare(d.fingerprint[:], e.fingerprint[:])
+	return same == 1
+}
+
+// name returns the name of the function in the text encoding.
+func (d Digest) name() string {
+	switch d.alg {
+	case Sumhash512:
+		return "sumhash512"
+	case Sumhash512Salted:
+		return "sumhash512-salted"
+	case Custom:
+		return "sumhash-" + hex.EncodeToString(d.fingerprint[:])
+	case CustomSalted:
+		return "sumhash-" + hex.EncodeToString(d.fingerprint[:]) + "-salted"
+	}
+	return ""
+}
+
+// String returns the text encoding of d, or "invalid" for the zero Digest.
+func (d Digest) String() string {
+	if d.IsZero() {
+		return "invalid"
+	}
+	return d.name() + ":" + hex.EncodeToString(d.sum[:])
+}
+
+// Parse decodes the text encoding of a Digest, as returned by String.
+func Parse(s string) (Digest, error) {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return Digest{}, fmt.Errorf("%w: missing algorithm in %q", ErrInvalid, s)
+	}
+	name, sum := s[:i], s[i+1:]
+
+	var d Digest
+	switch {
+	case name == "sumhash512":
+		d.alg = Sumhash512
+	case name == "sumhash512-salted":
+		d.alg = Sumhash512Salted
+	case strings.HasPrefix(name, "sumhash-"):
+		fp := strings.TrimPrefix(name, "sumhash-")
+		d.alg = Custom
+		if strings.HasSuffix(fp, "-salted") {
+			fp = strings.TrimSuffix(fp, "-salted")
+			d.alg = CustomSalted
+		}
+		if len(fp) != 2*len(d.fingerprint) {
+			return Digest{}, fmt.Errorf("%w: bad fingerprint in %q", ErrInvalid, s)
+		}
+		if _, err := hex.Decode(d.fingerprint[:], []byte(fp)); err != nil {
+			return Digest{}, fmt.Errorf("%w: bad fingerprint in %q", ErrInvalid, s)
+		}
+	default:
+		return Digest{}, fmt.Errorf("%w: unknown algorithm %q", ErrInvalid, name)
+	}
+
+	if len(sum) != 2*Size {
+		return Digest{}, fmt.Errorf("%w: hash of %d hex digits, want %d", ErrInvalid, len(sum), 2*Size)
+	}
+	if _, err := hex.Decode(d.sum[:], []byte(sum)); err != nil {
+		return Digest{}, fmt.Errorf("%w: bad hash: %v", ErrInvalid, err)
+	}
+	// Only the lower-case encoding of String is accepted, so that a Digest
+	// has a single text encoding.
+	if d.String() != s {
+		return Digest{}, fmt.Errorf("%w: %q is not in canonical form", ErrInvalid, s)
+	}
+	return d, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, with the encoding of String.
+func (d Digest) MarshalText() ([]byte, error) {
+	if d.IsZero() {
+		return nil, fmt.Errorf("%w: zero digest", ErrInvalid)
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, as Parse.
+func (d *Digest) UnmarshalText(text []byte) error {
+	dd, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = dd
+	return nil
+}
+
+// MarshalJSON encodes d as a JSON string holding its text encoding.
+func (d Digest) MarshalJSON() ([]byte, error) {
+	text, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON decodes a JSON string holding the text encoding of a Digest.
+func (d *Digest) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return d.UnmarshalText([]byte(s))
+}
+
+// MarshalBinary returns the binary encoding of d, described in the package
+// documentation.
+func (d Digest) MarshalBinary() ([]byte, error) {
+	if d.IsZero() {
+		return nil, fmt.Errorf("%w: zero digest", ErrInvalid)
+	}
+	b := make([]byte, 0, 1+len(d.fingerprint)+1+Size)
+	b = append(b, byte(d.alg))
+	if _, ok := d.Fingerprint(); ok {
+		b = append(b, d.fingerprint[:]...)
+	}
+	b = append(b, Size)
+	return append(b, d.sum[:]...), nil
+}
+
+// UnmarshalBinary decodes the binary encoding of a Digest.
+func (d *Digest) UnmarshalBinary(b []byte) error {
+	dd, rest, err := ParseBinary(b)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalid, len(rest))
+	}
+	*d = dd
+	return nil
+}
+
+// ParseBinary decodes the binary encoding of a Digest at the start of b, and
+// returns the bytes which follow it.
+func ParseBinary(b []byte) (d Digest, rest []byte, err error) {
+	if len(b) == 0 {
+		return Digest{}, nil, fmt.Errorf("%w: empty encoding", ErrInvalid)
+	}
+	d.alg = Algorithm(b[0])
+	b = b[1:]
+	switch d.alg {
+	case Sumhash512, Sumhash512Salted:
+	case Custom, CustomSalted:
+		if len(b) < len(d.fingerprint) {
+			return Digest{}, nil, fmt.Errorf("%w: truncated fingerprint", ErrInvalid)
+		}
+		b = b[copy(d.fingerprint[:], b):]
+	default:
+		return Digest{}, nil, fmt.Errorf("%w: unknown algorithm %d", ErrInvalid, d.alg)
+	}
+	if len(b) < 1 || b[0] != Size {
+		return Digest{}, nil, fmt.Errorf("%w: bad hash length", ErrInvalid)
+	}
+	b = b[1:]
+	if len(b) < Size {
+		return Digest{}, nil, fmt.Errorf("%w: truncated hash", ErrInvalid)
+	}
+	copy(d.sum[:], b)
+	return d, b[Size:], nil
+}
@@ -0,0 +1,184 @@
+package digest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-sumhash"
+)
+
+func testDigests(t *testing.T) []Digest {
+	salt := make([]byte, 64)
+	for i := range salt {
+		salt[i] = byte(i)
+	}
+	A, err := sumhash.RandomMatrixFromSeed([]byte("digest"), 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	custom := sumhash.NewDigest(A, nil)
+	custom.Write([]byte("abc"))
+	dc, err := FromHash(custom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	customSalted := sumhash.NewDigest(A.LookupTable(), salt)
+	customSalted.Write([]byte("abc"))
+	dcs, err := FromHash(customSalted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return []Digest{Sum512([]byte("abc")), SumSalted512(salt, []byte("abc")), dc, dcs}
+}
+
+func TestDigest(t *testing.T) {
+	digests := testDigests(t)
+	algs := []Algorithm{Sumhash512, Sumhash512Salted, Custom, CustomSalted}
+
+	want := sumhash.Sum512([]byte("abc"))
+	if sum := digests[0].Sum(); sum != want {
+		t.Errorf("got %x, want %x", sum, want)
+	}
+	if s := digests[0].String(); s != "sumhash512:"+hex.EncodeToString(want[:]) {
+		t.Errorf("unexpected text encoding %s", s)
+	}
+
+	A, _ := sumhash.RandomMatrixFromSeed([]byte("digest"), 8, 1024)
+	desc := A.Describe()
+	for i, d := range digests {
+		if d.Algorithm() != algs[i] {
+			t.Errorf("digest %d: got algorithm %d, want %d", i, d.Algorithm(), algs[i])
+		}
+		fp, ok := d.Fingerprint()
+		if ok != (i >= 2) || (ok && fp != desc.Fingerprint) {
+			t.Errorf("digest %d: unexpected fingerprint %x", i, fp)
+		}
+		for j, e := range digests {
+			if d.Equal(e) != (i == j) {
+				t.Errorf("digests %d and %d: Equal is %v", i, j, d.Equal(e))
+			}
+		}
+		// the same hash under another algorithm is a different digest
+		if other, _ := FromSum512(d.sum[:], i%2 == 0); d.Equal(other) {
+			t.Errorf("digest %d equals a digest of another algorithm", i)
+		}
+	}
+	if !strings.HasPrefix(digests[3].String(), "sumhash-"+hex.EncodeToString(desc.Fingerprint[:])+"-salted:") {
+		t.Errorf("unexpected text encoding %s", digests[3])
+	}
+
+	h := sumhash.New512Digest(nil)
+	h.Write([]byte("abc"))
+	d, err := FromHash(h)
+	if err != nil || !d.Equal(digests[0]) {
+		t.Errorf("FromHash on New512Digest: got %s, %v, want %s", d, err, digests[0])
+	}
+	if d, err := FromSum512(want[:], false); err != nil || !d.Equal(digests[0]) {
+		t.Errorf("FromSum512: got %s, %v, want %s", d, err, digests[0])
+	}
+	if _, err := FromSum512(want[:32], false); !errors.Is(err, ErrInvalid) {
+		t.Errorf("FromSum512 on a short hash: got error %v", err)
+	}
+	if _, err := FromHash(sumhash.NewDigest(A.NibbleTable(), nil)); !errors.Is(err, ErrInvalid) {
+		t.Errorf("FromHash with a NibbleTable: got error %v", err)
+	}
+	er := sumhash.New512ExtensionResistant(nil).(*sumhash.Digest)
+	if _, err := FromHash(er); !errors.Is(err, ErrInvalid) {
+		t.Errorf("FromHash in extension resistant mode: got error %v", err)
+	}
+}
+
+func TestEncodings(t *testing.T) {
+	for _, d := range testDigests(t) {
+		p, err := Parse(d.String())
+		if err != nil || !p.Equal(d) {
+			t.Errorf("Parse(%s): got %s, %v", d, p, err)
+		}
+
+		b, err := d.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var u Digest
+		if err := u.UnmarshalBinary(b); err != nil || !u.Equal(d) {
+			t.Errorf("binary encoding of %s: got %s, %v", d, u, err)
+		}
+		if _, rest, err := ParseBinary(append(b, 1, 2)); err != nil || len(rest) != 2 {
+			t.Errorf("ParseBinary: rest %x, error %v", rest, err)
+		}
+
+		type record struct {
+			Hash Digest `json:"hash"`
+		}
+		js, err := json.Marshal(record{d})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(js) != `{"hash":"`+d.String()+`"}` {
+			t.Errorf("unexpected JSON %s", js)
+		}
+		var r record
+		if err := json.Unmarshal(js, &r); err != nil || !r.Hash.Equal(d) {
+			t.Errorf("JSON encoding of %s: got %s, %v", d, r.Hash, err)
+		}
+	}
+
+	var zero Digest
+	if _, err := zero.MarshalText(); !errors.Is(err, ErrInvalid) {
+		t.Errorf("zero digest encoded")
+	}
+	if _, err := zero.MarshalBinary(); !errors.Is(err, ErrInvalid) {
+		t.Errorf("zero digest encoded")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	d := testDigests(t)
+	valid := d[0].String()
+	custom := d[2].String()
+	hexSum := valid[len("sumhash512:"):]
+
+	for _, s := range []string{
+		"",
+		hexSum,
+		"sha512:" + hexSum,
+		"sumhash512:" + hexSum[:126],
+		"sumhash512:" + hexSum + "00",
+		"sumhash512:" + strings.ToUpper(hexSum),
+		"sumhash512:" + hexSum[:126] + "zz",
+		"sumhash-00:" + hexSum,
+		"sumhash-" + strings.Repeat("z", 64) + ":" + hexSum,
+		strings.Replace(custom, "sumhash-", "sumhash--", 1),
+	} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Parse(%q): got error %v, want %v", s, err, ErrInvalid)
+		}
+	}
+
+	b, _ := d[2].MarshalBinary()
+	for _, bad := range [][]byte{nil, {0}, {9}, b[:10], b[:33], b[:len(b)-1], append([]byte{1, 32}, b[34:]...)} {
+		var u Digest
+		if err := u.UnmarshalBinary(bad); !errors.Is(err, ErrInvalid) {
+			t.Errorf("UnmarshalBinary(%x): got error %v, want %v", bad, err, ErrInvalid)
+		}
+	}
+	var u Digest
+	if err := u.UnmarshalJSON([]byte("12")); !errors.Is(err, ErrInvalid) {
+		t.Errorf("UnmarshalJSON of a number: got error %v", err)
+	}
+}
+
+func TestSum512Allocs(t *testing.T) {
+	msg := []byte("sumhash digest")
+	salt := make([]byte, sumhash.Sumhash512DigestBlockSize)
+	if n := testing.AllocsPerRun(10, func() { Sum512(msg) }); n != 0 {
+		t.Errorf("Sum512 allocated %v times, want 0", n)
+	}
+	if n := testing.AllocsPerRun(10, func() { SumSalted512(salt, msg) }); n != 0 {
+		t.Errorf("SumSalted512 allocated %v times, want 0", n)
+	}
+}
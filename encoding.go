@@ -0,0 +1,479 @@
+package sumhash
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	// ErrBadEncoding is returned when an encoded Matrix or LookupTable is
+	// malformed or truncated.
+	ErrBadEncoding = errors.New("sumhash: bad encoding")
+	// ErrChecksum is returned when the checksum of an encoded Matrix or
+	// LookupTable does not match its contents.
+	ErrChecksum = errors.New("sumhash: checksum mismatch")
+	// ErrSeedMismatch is returned when an encoded Matrix or LookupTable is not
+	// the one derived from the seed it records.
+	ErrSeedMismatch = errors.New("sumhash: compressor does not match its seed")
+)
+
+// The encoding of a Matrix or a LookupTable is:
+//
+//	magic       8 bytes, encodingMagicMatrix or encodingMagicTable
+//	version     1 byte, 1
+//	modulus     1 byte, the number of bits of the elements, 64
+//	flags       1 byte, encodingFlagSeeded if the seed is recorded
+//	reserved    1 byte, zero
+//	n           4 bytes, the number of rows
+//	m           4 bytes, the number of columns of the matrix
+//	seed length 4 bytes
+//	fingerprint 32 bytes, see fingerprint
+//	seed        seed length bytes, then zeros up to a multiple of 8 bytes
+//	data        the elements, row by row
+//	checksum    32 bytes, SHA3-256 of all the previous bytes
+//
+// All integers are little-endian. The elements of a LookupTable are in the
+// order of its [n][m/8][256] dimensions. The data starts at a multiple of 8
+// bytes from the start of the encoding, so that it can be mapped in memory.
+const (
+	encodingMagicMatrix = "sumhashM"
+	encodingMagicTable  = "sumhashT"
+	encodingVersion     = 1
+	encodingFlagSeeded  = 1 << 0
+	encodingHeaderSize  = 56
+	encodingChecksumSz  = 32
+)
+
+// encodingHeader is the decoded header of an encoded Matrix or LookupTable.
+type encodingHeader struct {
+	magic       string
+	n, m        int
+	seeded      bool
+	seed        []byte
+	fingerprint [32]byte
+}
+
+// dataOffset returns the offset of the data in the encoding.
+func (h *encodingHeader) dataOffset() int {
+	return encodingHeaderSize + (len(h.seed)+7)&^7
+}
+
+// dataLen returns the number of elements in the data.
+func (h *encodingHeader) dataLen() int {
+	if h.magic == encodingMagicTable {
+		return h.n * h.m / 8 * 256
+	}
+	return h.n * h.m
+}
+
+func (h *encodingHeader) append(b []byte) []byte {
+	b = append(b, h.magic...)
+	var flags byte
+	if h.seeded {
+		flags |= encodingFlagSeeded
+	}
+	b = append(b, encodingVersion, 64, flags, 0)
+	b = appendUint32(b, uint32(h.n))
+	b = appendUint32(b, uint32(h.m))
+	b = appendUint32(b, uint32(len(h.seed)))
+	b = append(b, h.fingerprint[:]...)
+	b = append(b, h.seed...)
+	for len(b)%8 != 0 {
+		b = append(b, 0)
+	}
+	return b
+}
+
+// parseEncodingHeader decodes the header at the start of b, which holds at
+// least the header and the seed.
+func parseEncodingHeader(b []byte, magic string) (*encodingHeader, error) {
+	if len(b) < encodingHeaderSize {
+		return nil, fmt.Errorf("%w: truncated header", ErrBadEncoding)
+	}
+	if string(b[:8]) != magic {
+		if string(b[:8]) == encodingMagicMatrix || string(b[:8]) == encodingMagicTable {
+			return nil, fmt.Errorf("%w: encoding of a %s, expected a %s", ErrBadEncoding, encodingKind(string(b[:8])), encodingKind(magic))
+		}
+		return nil, fmt.Errorf("%w: invalid identifier", ErrBadEncoding)
+	}
+	if b[8] != encodingVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrBadEncoding, b[8])
+	}
+	if b[9] != 64 {
+		return nil, fmt.Errorf("%w: unsupported modulus 2^%d", ErrBadEncoding, b[9])
+	}
+	if b[10]&^encodingFlagSeeded != 0 || b[11] != 0 {
+		return nil, fmt.Errorf("%w: unknown flags or reserved bits", ErrBadEncoding)
+	}
+
+	h := &encodingHeader{magic: magic, seeded: b[10]&encodingFlagSeeded != 0}
+	n := binary.LittleEndian.Uint32(b[12:])
+	m := binary.LittleEndian.Uint32(b[16:])
+	seedLen := binary.LittleEndian.Uint32(b[20:])
+	if n == 0 || m == 0 || m%8 != 0 || n > math.MaxUint16 || m > math.MaxUint16 {
+		return nil, fmt.Errorf("%w: n=%d and m=%d must be positive, fit in 16 bits, and m must be a multiple of 8", ErrBadDimensions, n, m)
+	}
+	if seedLen > math.MaxUint16 || (seedLen > 0 && !h.seeded) {
+		return nil, fmt.Errorf("%w: bad seed length %d", ErrBadEncoding, seedLen)
+	}
+	h.n, h.m = int(n), int(m)
+	copy(h.fingerprint[:], b[24:encodingHeaderSize])
+
+	if len(b) < encodingHeaderSize+int(seedLen) {
+		return nil, fmt.Errorf("%w: truncated seed", ErrBadEncoding)
+	}
+	if h.seeded {
+		h.seed = append([]byte{}, b[encodingHeaderSize:encodingHeaderSize+int(seedLen)]...)
+	}
+	return h, nil
+}
+
+func encodingKind(magic string) string {
+	if magic == encodingMagicTable {
+		return "LookupTable"
+	}
+	return "Matrix"
+}
+
+// encode returns the encoding of the n-by-m matrix, or of its lookup table,
+// whose elements are given by data.
+func encode(h *encodingHeader, data func(yield func(x uint64))) []byte {
+	b := make([]byte, 0, h.dataOffset()+8*h.dataLen()+encodingChecksumSz)
+	b = h.append(b)
+	data(func(x uint64) {
+		b = appendUint64(b, x)
+	})
+	sum := sha3.Sum256(b)
+	return append(b, sum[:]...)
+}
+
+// decode checks the encoding b, and returns its header and data.
+func decode(b []byte, magic string) (*encodingHeader, []byte, error) {
+	h, err := parseEncodingHeader(b, magic)
+	if err != nil {
+		return nil, nil, err
+	}
+	if want := h.dataOffset() + 8*h.dataLen() + encodingChecksumSz; len(b) != want {
+		if len(b) < want {
+			return nil, nil, fmt.Errorf("%w: truncated data, got %d bytes, want %d", ErrBadEncoding, len(b), want)
+		}
+		return nil, nil, fmt.Errorf("%w: %d trailing bytes", ErrBadEncoding, len(b)-want)
+	}
+	for _, c := range b[encodingHeaderSize+len(h.seed) : h.dataOffset()] {
+		if c != 0 {
+			return nil, nil, fmt.Errorf("%w: seed padding is not zero", ErrBadEncoding)
+		}
+	}
+	body := b[:len(b)-encodingChecksumSz]
+	if sum := sha3.Sum256(body); !bytes.Equal(sum[:], b[len(body):]) {
+		return nil, nil, ErrChecksum
+	}
+	return h, body[h.dataOffset():], nil
+}
+
+// checkDecoded checks the fingerprint and the seed recorded in h against the
+// decoded compressor c. If a seed is recorded, sameAs must check that c is
+// the compressor derived from the matrix of the seed.
+func checkDecoded(h *encodingHeader, c Compressor, sameAs func(A Matrix) bool) error {
+	if fingerprint(c) != h.fingerprint {
+		return fmt.Errorf("%w: fingerprint does not match the data", ErrBadEncoding)
+	}
+	if !h.seeded {
+		return nil
+	}
+	A, err := RandomMatrixFromSeed(h.seed, h.n, h.m)
+	if err != nil {
+		return err
+	}
+	if !sameAs(A) {
+		return ErrSeedMismatch
+	}
+	return nil
+}
+
+// MarshalBinary encodes the matrix, with its dimensions, its fingerprint and a
+// checksum. The encoding does not record a seed; see MarshalSeeded.
+func (A Matrix) MarshalBinary() ([]byte, error) {
+	return A.marshal(nil)
+}
+
+// marshal encodes the matrix, recording seed if it is not nil.
+func (A Matrix) marshal(seed []byte) ([]byte, error) {
+	if err := A.validate(); err != nil {
+		return nil, err
+	}
+	h := &encodingHeader{magic: encodingMagicMatrix, n: len(A), m: len(A[0]), seeded: seed != nil, seed: seed, fingerprint: fingerprint(A)}
+	return encode(h, func(yield func(x uint64)) {
+		for i := range A {
+			for _, x := range A[i] {
+				yield(x)
+			}
+		}
+	}), nil
+}
+
+// UnmarshalBinary decodes a matrix encoded by MarshalBinary or MarshalSeeded.
+// It checks the checksum and the fingerprint, and, if the encoding records a
+// seed, that the matrix is the one derived from it by RandomMatrixFromSeed.
+func (A *Matrix) UnmarshalBinary(b []byte) error {
+	h, data, err := decode(b, encodingMagicMatrix)
+	if err != nil {
+		return err
+	}
+
+	B := make(Matrix, h.n)
+	for i := range B {
+		B[i] = make([]uint64, h.m)
+		for j := range B[i] {
+			B[i][j], data = consumeUint64(data)
+		}
+	}
+	err = checkDecoded(h, B, B.equal)
+	if err != nil {
+		return err
+	}
+	*A = B
+	return nil
+}
+
+// WriteTo writes the encoding of the matrix, as returned by MarshalBinary, to w.
+func (A Matrix) WriteTo(w io.Writer) (int64, error) {
+	b, err := A.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+// ReadFrom reads an encoded matrix from r, and decodes it as UnmarshalBinary
+// does. It reads exactly the length of the encoding given by its header.
+func (A *Matrix) ReadFrom(r io.Reader) (int64, error) {
+	b, err := readEncoding(r, encodingMagicMatrix)
+	if err != nil {
+		return int64(len(b)), err
+	}
+	return int64(len(b)), A.UnmarshalBinary(b)
+}
+
+// MarshalBinary encodes the lookup table, with its dimensions, its
+// fingerprint and a checksum. The encoding does not record a seed; see
+// MarshalSeeded.
+func (T LookupTable) MarshalBinary() ([]byte, error) {
+	return T.marshal(nil)
+}
+
+// marshal encodes the lookup table, recording seed if it is not nil.
+func (T LookupTable) marshal(seed []byte) ([]byte, error) {
+	if err := T.validate(); err != nil {
+		return nil, err
+	}
+	h := &encodingHeader{magic: encodingMagicTable, n: len(T), m: 8 * len(T[0]), seeded: seed != nil, seed: seed, fingerprint: fingerprint(T)}
+	return encode(h, func(yield func(x uint64)) {
+		for i := range T {
+			for j := range T[i] {
+				for _, x := range T[i][j] {
+					yield(x)
+				}
+			}
+		}
+	}), nil
+}
+
+// UnmarshalBinary decodes a lookup table encoded by MarshalBinary or
+// MarshalSeeded. It checks the checksum and the fingerprint, and, if the
+// encoding records a seed, that the table is the one of the matrix derived
+// from it by RandomMatrixFromSeed. The last check costs as much as building
+// the table.
+func (T *LookupTable) UnmarshalBinary(b []byte) error {
+	h, data, err := decode(b, encodingMagicTable)
+	if err != nil {
+		return err
+	}
+
+	U := make(LookupTable, h.n)
+	for i := range U {
+		U[i] = make([][256]uint64, h.m/8)
+		for j := range U[i] {
+			for k := range U[i][j] {
+				U[i][j][k], data = consumeUint64(data)
+			}
+		}
+	}
+	err = checkDecoded(h, U, U.derivedFrom)
+	if err != nil {
+		return err
+	}
+	*T = U
+	return nil
+}
+
+// WriteTo writes the encoding of the lookup table, as returned by
+// MarshalBinary, to w.
+func (T LookupTable) WriteTo(w io.Writer) (int64, error) {
+	b, err := T.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+// ReadFrom reads an encoded lookup table from r, and decodes it as
+// UnmarshalBinary does. It reads exactly the length of the encoding given by
+// its header.
+func (T *LookupTable) ReadFrom(r io.Reader) (int64, error) {
+	b, err := readEncoding(r, encodingMagicTable)
+	if err != nil {
+		return int64(len(b)), err
+	}
+	return int64(len(b)), T.UnmarshalBinary(b)
+}
+
+// equal reports whether A and B have the same elements. They must have the
+// same dimensions.
+func (A Matrix) equal(B Matrix) bool {
+	for i := range A {
+		for j := range A[i] {
+			if A[i][j] != B[i][j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// derivedFrom reports whether T is the lookup table of A. They must have the
+// same dimensions.
+func (T LookupTable) derivedFrom(A Matrix) bool {
+	for i := range A {
+		for j := range T[i] {
+			for b := range T[i][j] {
+				if T[i][j][b] != sumBits(A[i][8*j:8*j+8], byte(b)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// derivedFrom reports whether T is the nibble table of A. They must have the
+// same dimensions.
+func (T NibbleTable) derivedFrom(A Matrix) bool {
+	for i := range A {
+		for j := range T[i] {
+			for b := range T[i][j] {
+				var x uint64
+				for k := 0; k < 4; k++ {
+					x += A[i][4*j+k] & -uint64((b>>k)&1)
+				}
+				if T[i][j][b] != x {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// derivedFrom reports whether T is the row-interleaved lookup table of A.
+func (T InterleavedLookupTable) derivedFrom(A Matrix) bool {
+	if T.n != len(A) || len(T.sums) != len(A[0])/8*256*T.n {
+		return false
+	}
+	for j := 0; j < len(A[0]); j += 8 {
+		for b := 0; b < 256; b++ {
+			e := T.sums[(j/8*256+b)*T.n:][:T.n]
+			for i := range A {
+				if e[i] != sumBits(A[i][j:j+8], byte(b)) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// MarshalSeeded encodes c, which must be a Matrix or a LookupTable, and
+// records that it is derived from seed by RandomMatrixFromSeed, so that the
+// decoder can check it. It returns ErrSeedMismatch if c is not derived from
+// seed.
+func MarshalSeeded(c Compressor, seed []byte) ([]byte, error) {
+	if seed == nil {
+		seed = []byte{}
+	}
+	if len(seed) > math.MaxUint16 {
+		return nil, fmt.Errorf("%w: seed length %d is too large", ErrBadEncoding, len(seed))
+	}
+	var b []byte
+	var err error
+	switch c := c.(type) {
+	case Matrix:
+		b, err = c.marshal(seed)
+	case LookupTable:
+		b, err = c.marshal(seed)
+	default:
+		return nil, fmt.Errorf("sumhash: cannot encode a compressor of type %T", c)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	h, _ := parseEncodingHeader(b, string(b[:8]))
+	A, err := RandomMatrixFromSeed(seed, h.n, h.m)
+	if err != nil {
+		return nil, err
+	}
+	if fingerprint(A) != h.fingerprint {
+		return nil, ErrSeedMismatch
+	}
+	return b, nil
+}
+
+// readEncoding reads an encoding of the given kind from r. It returns the
+// bytes read so far if the encoding is truncated.
+func readEncoding(r io.Reader, magic string) ([]byte, error) {
+	b := make([]byte, encodingHeaderSize)
+	if n, err := io.ReadFull(r, b); err != nil {
+		return b[:n], fmt.Errorf("%w: truncated header: %v", ErrBadEncoding, err)
+	}
+	seedLen := binary.LittleEndian.Uint32(b[20:])
+	if seedLen <= math.MaxUint16 {
+		seed := make([]byte, seedLen)
+		if n, err := io.ReadFull(r, seed); err != nil {
+			return append(b, seed[:n]...), fmt.Errorf("%w: truncated seed: %v", ErrBadEncoding, err)
+		}
+		b = append(b, seed...)
+	}
+	h, err := parseEncodingHeader(b, magic)
+	if err != nil {
+		return b, err
+	}
+
+	// The rest of the encoding is read as it comes, rather than allocated
+	// from the sizes of the header, which might be bogus.
+	rest := int64(h.dataOffset()-len(b)) + 8*int64(h.dataLen()) + encodingChecksumSz
+	var buf bytes.Buffer
+	buf.Write(b)
+	n, err := io.Copy(&buf, io.LimitReader(r, rest))
+	if err != nil {
+		return buf.Bytes(), err
+	}
+	if n != rest {
+		return buf.Bytes(), fmt.Errorf("%w: truncated data, got %d bytes, want %d", ErrBadEncoding, n, rest)
+	}
+	return buf.Bytes(), nil
+}
+
+func appendUint32(b []byte, x uint32) []byte {
+	var a [4]byte
+	binary.LittleEndian.PutUint32(a[:], x)
+	return append(b, a[:]...)
+}
@@ -0,0 +1,228 @@
+package sumhash
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestEncodingRoundTrip(t *testing.T) {
+	seed := []byte("encoding")
+	A, err := RandomMatrixFromSeed(seed, 4, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	T := A.LookupTable()
+
+	for _, s := range [][]byte{nil, seed} {
+		var bm, bt []byte
+		if s == nil {
+			bm, err = A.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			bt, err = T.MarshalBinary()
+		} else {
+			bm, err = MarshalSeeded(A, s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bt, err = MarshalSeeded(T, s)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var A2 Matrix
+		if err := A2.UnmarshalBinary(bm); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(A, A2) {
+			t.Errorf("decoded matrix differs (seeded %v)", s != nil)
+		}
+		var T2 LookupTable
+		if err := T2.UnmarshalBinary(bt); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(T, T2) {
+			t.Errorf("decoded lookup table differs (seeded %v)", s != nil)
+		}
+
+		// ReadFrom stops at the end of the encoding
+		var buf bytes.Buffer
+		buf.Write(bt)
+		buf.WriteString("next")
+		var T3 LookupTable
+		if n, err := T3.ReadFrom(&buf); err != nil || n != int64(len(bt)) {
+			t.Fatalf("ReadFrom: read %d bytes of %d, error %v", n, len(bt), err)
+		}
+		if buf.String() != "next" || !reflect.DeepEqual(T, T3) {
+			t.Errorf("ReadFrom did not decode the lookup table (seeded %v)", s != nil)
+		}
+	}
+
+	var buf bytes.Buffer
+	if _, err := A.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var A3 Matrix
+	if _, err := A3.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(A, A3) {
+		t.Errorf("WriteTo and ReadFrom do not round trip")
+	}
+}
+
+func TestEncodingErrors(t *testing.T) {
+	seed := []byte("encoding")
+	A, err := RandomMatrixFromSeed(seed, 4, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := MarshalSeeded(A, seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := MarshalSeeded(A, []byte("other seed")); !errors.Is(err, ErrSeedMismatch) {
+		t.Errorf("MarshalSeeded with a wrong seed: got error %v, want %v", err, ErrSeedMismatch)
+	}
+
+	for _, l := range []int{0, 7, encodingHeaderSize - 1, encodingHeaderSize + 3, len(b) / 2, len(b) - 1} {
+		var A2 Matrix
+		if err := A2.UnmarshalBinary(b[:l]); !errors.Is(err, ErrBadEncoding) {
+			t.Errorf("truncated to %d bytes: got error %v, want %v", l, err, ErrBadEncoding)
+		}
+		if _, err := A2.ReadFrom(bytes.NewReader(b[:l])); !errors.Is(err, ErrBadEncoding) {
+			t.Errorf("ReadFrom truncated to %d bytes: got error %v, want %v", l, err, ErrBadEncoding)
+		}
+	}
+
+	tamper := func(f func(b []byte)) []byte {
+		bad := append([]byte(nil), b...)
+		f(bad)
+		return bad
+	}
+
+	tests := []struct {
+		name string
+		b    []byte
+		err  error
+	}{
+		{"trailing bytes", append(append([]byte(nil), b...), 0), ErrBadEncoding},
+		{"magic", tamper(func(b []byte) { b[0] ^= 1 }), ErrBadEncoding},
+		{"lookup table", tamper(func(b []byte) { b[7] = 'T' }), ErrBadEncoding},
+		{"version", tamper(func(b []byte) { b[8] = 2 }), ErrBadEncoding},
+		{"modulus", tamper(func(b []byte) { b[9] = 32 }), ErrBadEncoding},
+		{"flags", tamper(func(b []byte) { b[10] |= 2 }), ErrBadEncoding},
+		{"dimensions", tamper(func(b []byte) { b[16] = 7 }), ErrBadDimensions},
+		{"data", tamper(func(b []byte) { b[len(b)-40] ^= 1 }), ErrChecksum},
+		{"checksum", tamper(func(b []byte) { b[len(b)-1] ^= 1 }), ErrChecksum},
+		{"fingerprint", tamper(func(b []byte) { b[30] ^= 1; rechecksum(b) }), ErrBadEncoding},
+		{"seed", tamper(func(b []byte) { b[encodingHeaderSize] ^= 1; rechecksum(b) }), ErrSeedMismatch},
+	}
+	for _, test := range tests {
+		var A2 Matrix
+		if err := A2.UnmarshalBinary(test.b); !errors.Is(err, test.err) {
+			t.Errorf("%s: got error %v, want %v", test.name, err, test.err)
+		}
+		if A2 != nil {
+			t.Errorf("%s: matrix set on error", test.name)
+		}
+	}
+
+	// A matrix which differs from that of its seed in a single element has
+	// another fingerprint, and it is caught by the seed.
+	B := make(Matrix, len(A))
+	for i := range A {
+		B[i] = append([]uint64(nil), A[i]...)
+	}
+	B[1][len(B[1])-1]++
+	if fingerprint(A) == fingerprint(B) {
+		t.Fatalf("fingerprint did not change")
+	}
+	bb, err := B.marshal(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var A2 Matrix
+	if err := A2.UnmarshalBinary(bb); !errors.Is(err, ErrSeedMismatch) {
+		t.Errorf("matrix which does not match its seed: got error %v, want %v", err, ErrSeedMismatch)
+	}
+
+	// A lookup table is fingerprinted by its entries for single bits, so a
+	// change of another entry is only caught by the seed.
+	T := A.LookupTable()
+	T[0][0][3]++
+	if fingerprint(A) != fingerprint(T) {
+		t.Fatalf("fingerprint changed")
+	}
+	var T2 LookupTable
+	bb, _ = T.marshal(seed)
+	if err := T2.UnmarshalBinary(bb); !errors.Is(err, ErrSeedMismatch) {
+		t.Errorf("lookup table with the fingerprint of its seed: got error %v, want %v", err, ErrSeedMismatch)
+	}
+}
+
+func TestEncodingSumhash512(t *testing.T) {
+	A := sumhash512Matrix()
+	b, err := MarshalSeeded(A, sumhash512Seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 56 + 8 + 8*8*1024 + 32; len(b) != want {
+		t.Errorf("encoding has %d bytes, want %d", len(b), want)
+	}
+	sum := sha3.Sum256(b)
+	if hex.EncodeToString(sum[:]) != "f6a239b9922e9aebc71fc6119afd2402f4b44c8371ca4e3cd94e02d763fd966c" {
+		t.Errorf("encoding has SHA3-256 %x, want %s", sum, "f6a239b9922e9aebc71fc6119afd2402f4b44c8371ca4e3cd94e02d763fd966c")
+	}
+
+	var T LookupTable
+	bt, err := MarshalSeeded(A.LookupTable(), sumhash512Seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := T.UnmarshalBinary(bt); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetSumhash512Compressor(T); err != nil {
+		t.Fatal(err)
+	}
+	defer ReleaseSumhash512Compressor()
+	if Sum512(nil) != sum512(&instance{c: A}, nil, nil) {
+		t.Errorf("decoded lookup table gives a different hash")
+	}
+}
+
+func BenchmarkLookupTableUnmarshal(b *testing.B) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		b.Fatal(err)
+	}
+	enc, err := A.LookupTable().MarshalBinary()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(enc)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var T LookupTable
+		if err := T.UnmarshalBinary(enc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// rechecksum recomputes the checksum of a changed encoding, and returns it.
+func rechecksum(b []byte) []byte {
+	sum := sha3.Sum256(b[:len(b)-encodingChecksumSz])
+	copy(b[len(b)-encodingChecksumSz:], sum[:])
+	return b
+}
@@ -0,0 +1,131 @@
+//go:build ignore
+// +build ignore
+
+// This program generates sumhash512_matrix.go, which holds the matrix of the
+// sumhash512 instance, and optionally its lookup table. Invoke it as
+//
+//	go run gen.go -output sumhash512_matrix.go [-table]
+//
+// It does not import the sumhash package, which depends on its output.
+// Instead, it derives the matrix from the seed as described in the spec, and
+// the package tests check the result against RandomMatrixFromSeed.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	output = flag.String("output", "sumhash512_matrix.go", "output file name")
+	table  = flag.Bool("table", false, "also generate the lookup table")
+)
+
+const (
+	seed = "Algorand"
+	n    = 8
+	m    = 1024
+)
+
+func main() {
+	flag.Parse()
+
+	A := matrixFromSeed([]byte(seed), n, m)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by go run gen.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package sumhash\n\n")
+
+	fmt.Fprintf(&buf, "// sumhash512MatrixData is the matrix of the sumhash512 instance, as derived by\n")
+	fmt.Fprintf(&buf, "// RandomMatrixFromSeed([]byte(%q), %d, %d).\n", seed, n, m)
+	fmt.Fprintf(&buf, "var sumhash512MatrixData = [%d][%d]uint64{\n", n, m)
+	for i := range A {
+		writeWords(&buf, A[i])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	if *table {
+		At := lookupTable(A)
+		fmt.Fprintf(&buf, "\n// sumhash512TableArray is the lookup table of sumhash512MatrixData.\n")
+		fmt.Fprintf(&buf, "var sumhash512TableArray = [%d][%d][256]uint64{\n", n, m/8)
+		for i := range At {
+			fmt.Fprintf(&buf, "{\n")
+			for j := range At[i] {
+				writeWords(&buf, At[i][j][:])
+			}
+			fmt.Fprintf(&buf, "},\n")
+		}
+		fmt.Fprintf(&buf, "}\n\n")
+		fmt.Fprintf(&buf, "func init() {\n")
+		fmt.Fprintf(&buf, "sumhash512TableData = &sumhash512TableArray\n")
+		fmt.Fprintf(&buf, "}\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeWords(buf *bytes.Buffer, words []uint64) {
+	fmt.Fprintf(buf, "{\n")
+	for j, w := range words {
+		fmt.Fprintf(buf, "0x%016x,", w)
+		if j%4 == 3 {
+			fmt.Fprintf(buf, "\n")
+		} else {
+			fmt.Fprintf(buf, " ")
+		}
+	}
+	fmt.Fprintf(buf, "},\n")
+}
+
+// matrixFromSeed reads the n-by-m matrix from SHAKE256(u || n || m || seed),
+// where u=64 and the dimensions are encoded as 16-bit little-endian integers.
+func matrixFromSeed(seed []byte, n int, m int) [][]uint64 {
+	xof := sha3.NewShake256()
+	binary.Write(xof, binary.LittleEndian, uint16(64))
+	binary.Write(xof, binary.LittleEndian, uint16(n))
+	binary.Write(xof, binary.LittleEndian, uint16(m))
+	xof.Write(seed)
+
+	A := make([][]uint64, n)
+	w := make([]byte, 8)
+	for i := range A {
+		A[i] = make([]uint64, m)
+		for j := range A[i] {
+			xof.Read(w)
+			A[i][j] = binary.LittleEndian.Uint64(w)
+		}
+	}
+	return A
+}
+
+// lookupTable computes, for each row and each byte of input, the sum of the
+// matrix elements selected by the bits of the byte.
+func lookupTable(A [][]uint64) [][][256]uint64 {
+	At := make([][][256]uint64, len(A))
+	for i := range A {
+		At[i] = make([][256]uint64, len(A[i])/8)
+		for j := range At[i] {
+			for b := 0; b < 256; b++ {
+				for k := 0; k < 8; k++ {
+					if b>>k&1 == 1 {
+						At[i][j][b] += A[i][8*j+k]
+					}
+				}
+			}
+		}
+	}
+	return At
+}
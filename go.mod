@@ -2,4 +2,7 @@ module github.com/algorand/go-sumhash
 
 go 1.16
 
-require golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
+require (
+	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
+	golang.org/x/sys v0.0.0-20210615035016-665e8c7367d1
+)
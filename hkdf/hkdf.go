@@ -0,0 +1,70 @@
+// Package hkdf implements the HMAC-based Extract-and-Expand Key Derivation
+// Function (HKDF, RFC 5869) over sumhash, so that keys can be derived without
+// relying on another hash function.
+//
+// The functions take the hash.Hash factory of the underlying hash, as in
+// golang.org/x/crypto/hkdf. Sumhash512 is the factory of the sumhash512
+// instance, with a 64-byte block size and a 64-byte output, so that up to
+// 255*64 bytes can be derived from a pseudorandom key.
+package hkdf
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/algorand/go-sumhash"
+	"golang.org/x/crypto/hkdf"
+)
+
+// ErrOutputTooLong is returned when requesting more output than HKDF can
+// derive, which is 255 times the output size of the hash.
+var ErrOutputTooLong = errors.New("hkdf: requested output is too long")
+
+// Sumhash512 returns a sumhash512 hash.Hash for use with the functions of this
+// package. It runs in constant time, since it processes secret keys. See
+// sumhash.NewMAC.
+func Sumhash512() hash.Hash {
+	return sumhash.New512ConstantTime(nil)
+}
+
+// MaxOutputLen returns the maximum number of bytes that can be derived from a
+// pseudorandom key with the given hash.
+func MaxOutputLen(hash func() hash.Hash) int {
+	return 255 * hash().Size()
+}
+
+// Extract generates a pseudorandom key for use with Expand from an input
+// secret and an optional independent salt. If salt is nil, a salt of
+// hash().Size() zero bytes is used.
+func Extract(hash func() hash.Hash, secret, salt []byte) []byte {
+	return hkdf.Extract(hash, secret, salt)
+}
+
+// Expand returns a Reader, from which keys can be read, using the given
+// pseudorandom key and optional context info. The Reader returns an error
+// once MaxOutputLen(hash) bytes have been read.
+func Expand(hash func() hash.Hash, pseudorandomKey, info []byte) io.Reader {
+	return hkdf.Expand(hash, pseudorandomKey, info)
+}
+
+// New returns a Reader, from which keys can be read, using the given hash,
+// secret, salt and context info. Salt and info can be nil.
+func New(hash func() hash.Hash, secret, salt, info []byte) io.Reader {
+	return hkdf.New(hash, secret, salt, info)
+}
+
+// Key derives a key of the given length from the secret, salt and context
+// info. Unlike reading from New, it checks the length before computing
+// anything.
+func Key(hash func() hash.Hash, secret, salt, info []byte, length int) ([]byte, error) {
+	if length < 0 || length > MaxOutputLen(hash) {
+		return nil, fmt.Errorf("%w: %d bytes, at most %d", ErrOutputTooLong, length, MaxOutputLen(hash))
+	}
+	key := make([]byte, length)
+	if _, err := io.ReadFull(New(hash, secret, salt, info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,132 @@
+package hkdf
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/algorand/go-sumhash"
+)
+
+func seq(from, to byte) []byte {
+	var b []byte
+	for i := int(from); i <= int(to); i++ {
+		b = append(b, byte(i))
+	}
+	return b
+}
+
+// hkdfTests use the inputs of the test cases of RFC 5869.
+var hkdfTests = []struct {
+	secret []byte
+	salt   []byte
+	info   []byte
+	prk    string
+	okm    string
+}{
+	{
+		bytes.Repeat([]byte{0x0b}, 22),
+		seq(0x00, 0x0c),
+		seq(0xf0, 0xf9),
+		"1483bb3f7ced531576191564e1add4c920b9f441c1983350ff8d752b33261351c303595fb85c40e75ed45d9b0699d6d6ffd47372c36398590c3bc8d57521adbf",
+		"24e5f4e343a585e9f83cceec308957b27f7e72f3e5582def0293953a4bef9120a2f98dccd52409667fd6",
+	},
+	{
+		seq(0x00, 0x4f),
+		seq(0x60, 0xaf),
+		seq(0xb0, 0xff),
+		"cbabb9f8a1087933af0268dc8b6da27cb3ba9b72b9c8bcb14a7160b56af756f0e8772a938e4603cab13af726f70aa886be0e0396c2a06929bc108efd48462d84",
+		"640d207a3219262cf65c3ec3dd264eba45b806d1952a8c4560a361435a866952af2f96138b1ae8d226f61208f7b494e672e2cb508089403442d385fd37522009fef78efeb7eea0197c7c9ff3fcc85033021c",
+	},
+	{
+		bytes.Repeat([]byte{0x0b}, 22),
+		nil,
+		nil,
+		"4a2e5019db7f7230fe0415c43985b678bb85953bc4b78bfda1e69126f033bb0a94f935d9b462308ee924a7ac7f45d3f02fc75a617cda2406e65f7864ed990eed",
+		"8d3059003966c8a4d2649037fde2821210d4d00692fb7f30ea001f9768e91e73850461bd771e414e7d64",
+	},
+}
+
+func TestHKDFVector(t *testing.T) {
+	lengths := []int{42, 82, 42}
+	for i, test := range hkdfTests {
+		prk := Extract(Sumhash512, test.secret, test.salt)
+		if hex.EncodeToString(prk) != test.prk {
+			t.Errorf("test case %d: got PRK %x, want %s", i+1, prk, test.prk)
+		}
+
+		okm := make([]byte, lengths[i])
+		if _, err := io.ReadFull(Expand(Sumhash512, prk, test.info), okm); err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(okm) != test.okm {
+			t.Errorf("test case %d: got OKM %x, want %s", i+1, okm, test.okm)
+		}
+
+		key, err := Key(Sumhash512, test.secret, test.salt, test.info, lengths[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(key, okm) {
+			t.Errorf("test case %d: Key differs from Extract and Expand", i+1)
+		}
+	}
+}
+
+func TestHKDFConstruction(t *testing.T) {
+	secret := []byte("input keying material")
+	salt := []byte("salt")
+	info := []byte("info")
+
+	mac := sumhash.NewMAC(salt)
+	mac.Write(secret)
+	prk := mac.Sum(nil)
+	if !bytes.Equal(Extract(Sumhash512, secret, salt), prk) {
+		t.Errorf("PRK differs from HMAC(salt, secret)")
+	}
+
+	mac = sumhash.NewMAC(make([]byte, sumhash.Sumhash512DigestSize))
+	mac.Write(secret)
+	if !bytes.Equal(Extract(Sumhash512, secret, nil), mac.Sum(nil)) {
+		t.Errorf("PRK with a nil salt differs from HMAC(zeros, secret)")
+	}
+
+	// T(1) = HMAC(PRK, info || 0x01), T(2) = HMAC(PRK, T(1) || info || 0x02)
+	mac = sumhash.NewMAC(prk)
+	mac.Write(info)
+	mac.Write([]byte{1})
+	t1 := mac.Sum(nil)
+	mac.Reset()
+	mac.Write(t1)
+	mac.Write(info)
+	mac.Write([]byte{2})
+	t2 := mac.Sum(nil)
+
+	okm := make([]byte, 128)
+	io.ReadFull(Expand(Sumhash512, prk, info), okm)
+	if !bytes.Equal(okm, append(t1, t2...)) {
+		t.Errorf("OKM differs from T(1) || T(2)")
+	}
+}
+
+func TestHKDFMaxOutput(t *testing.T) {
+	if MaxOutputLen(Sumhash512) != 255*64 {
+		t.Errorf("got max output length %d, want %d", MaxOutputLen(Sumhash512), 255*64)
+	}
+
+	prk := Extract(Sumhash512, []byte("secret"), nil)
+	r := Expand(Sumhash512, prk, nil)
+	okm := make([]byte, 255*64)
+	if _, err := io.ReadFull(r, okm); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(make([]byte, 1)); err == nil {
+		t.Errorf("read past the maximum output length")
+	}
+
+	if _, err := Key(Sumhash512, []byte("secret"), nil, nil, 255*64+1); !errors.Is(err, ErrOutputTooLong) {
+		t.Errorf("got error %v, want %v", err, ErrOutputTooLong)
+	}
+}
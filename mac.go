@@ -0,0 +1,31 @@
+package sumhash
+
+import (
+	"crypto/hmac"
+	"hash"
+)
+
+// NewMAC returns a new hash.Hash computing HMAC (RFC 2104) with sumhash512 as
+// the underlying hash function, keyed with key. It is a MAC and a PRF, unlike
+// the salted mode of New, whose salt is public.
+//
+// The key goes through the compression function, so NewMAC uses the
+// constant-time compressor of New512ConstantTime. The lookup tables, such as
+// SumhashCompressor, index memory with their input and must not be used with
+// secret keys. The HMAC security argument relies on the compression function
+// keyed through its chain value being a PRF; unlike the collision resistance
+// of sumhash, this is not reduced to the subset-sum problem.
+//
+// Use Equal to compare MACs.
+func NewMAC(key []byte) hash.Hash {
+	return hmac.New(newConstantTime512, key)
+}
+
+// Equal compares two MACs for equality without leaking timing information.
+func Equal(mac1, mac2 []byte) bool {
+	return hmac.Equal(mac1, mac2)
+}
+
+func newConstantTime512() hash.Hash {
+	return New512ConstantTime(nil)
+}
@@ -0,0 +1,109 @@
+package sumhash
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// macTests use the inputs of the test cases of RFC 4231.
+var macTests = []struct {
+	key    []byte
+	data   []byte
+	output string
+}{
+	{
+		bytes.Repeat([]byte{0x0b}, 20),
+		[]byte("Hi There"),
+		"6c059c9213f0e152dc9d6c5bc9ac0595d1b428a4312f0192642667087d3f7e16ea3f033ad3f31d4d2cebe48eda0e8dac59b544fdab0f58e449dc2f1920ead3bf",
+	},
+	{
+		[]byte("Jefe"),
+		[]byte("what do ya want for nothing?"),
+		"60d491665813eeeef509fd08ed0727d81f17721b775a7890e7d22ab5c8ae47032beaf77872512a96eb798a2f74c40b8f652701525aebdb140701f8f4db2767b0",
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 20),
+		bytes.Repeat([]byte{0xdd}, 50),
+		"c15703d523dc9c1393cbe9fbd91e3bd35953c148b3b68a0bc62256528aa8d5da07a450a1ba4753fa5d5f3056f57ef1d1a08d0f884efd15561bba7153daca0905",
+	},
+	{
+		[]byte{
+			0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d,
+			0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19,
+		},
+		bytes.Repeat([]byte{0xcd}, 50),
+		"cc6bdc2af3e274190ef4ad3d5a9b0747afb60df54d407e1449c246e60b2f7505c1ed9f31b025b4590028258b18cb24999d1558806c71c12f61d91a7ecc49e657",
+	},
+	{
+		bytes.Repeat([]byte{0x0c}, 20),
+		[]byte("Test With Truncation"),
+		"bf3de7749bb841b1b40cfde3dc163e4e70b781967c9419363381e0b65994fa44fd41cd8d4b09749430233d7c189691c7c65e87d15489cd1ca599461542bce9c1",
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 131),
+		[]byte("Test Using Larger Than Block-Size Key - Hash Key First"),
+		"c56350c179a39d74668ea5fcf209d36d7ad3cd0e0cd306a52bf7fdd4eb617818c7f8ac80cd5729ff77d8d3bd5beefc5b9efd061bc5ece8ff884e4785afe7a5c9",
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 131),
+		[]byte("This is a test using a larger than block-size key and a larger than block-size data. The key needs to be hashed before being used by the HMAC algorithm."),
+		"400e31df7cf30f469777aa525459d0e6a4f783847ae2efe9fa60692a128d23dcd8529f1c182af71d48f5df9b1ed3d4a0206e662ab0dc015b8a636116c269b5e4",
+	},
+}
+
+func TestMACVector(t *testing.T) {
+	for i, test := range macTests {
+		h := NewMAC(test.key)
+		h.Write(test.data)
+		mac := h.Sum(nil)
+		if hex.EncodeToString(mac) != test.output {
+			t.Errorf("test case %d: got %x, want %s", i+1, mac, test.output)
+		}
+
+		want, _ := hex.DecodeString(test.output)
+		if !Equal(mac, want) {
+			t.Errorf("test case %d: Equal returned false on equal MACs", i+1)
+		}
+		mac[len(mac)-1] ^= 1
+		if Equal(mac, want) {
+			t.Errorf("test case %d: Equal returned true on different MACs", i+1)
+		}
+	}
+}
+
+func TestMACConstruction(t *testing.T) {
+	for i, test := range macTests {
+		key := test.key
+		if len(key) > Sumhash512DigestBlockSize {
+			sum := Sum512(key)
+			key = sum[:]
+		}
+		ipad := make([]byte, Sumhash512DigestBlockSize)
+		opad := make([]byte, Sumhash512DigestBlockSize)
+		copy(ipad, key)
+		copy(opad, key)
+		for j := range ipad {
+			ipad[j] ^= 0x36
+			opad[j] ^= 0x5c
+		}
+
+		inner := Sum512(append(ipad, test.data...))
+		outer := Sum512(append(opad, inner[:]...))
+		if hex.EncodeToString(outer[:]) != test.output {
+			t.Errorf("test case %d: HMAC differs from its definition", i+1)
+		}
+	}
+}
+
+func BenchmarkMAC(b *testing.B) {
+	key := bytes.Repeat([]byte{0xaa}, 32)
+	msg := make([]byte, 600)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h := NewMAC(key)
+		h.Write(msg)
+		_ = h.Sum(nil)
+	}
+}
@@ -0,0 +1,215 @@
+package sumhash
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// nativeLittleEndian is true if the elements of an encoded lookup table can
+// be used in place.
+var nativeLittleEndian = *(*uint16)(unsafe.Pointer(&[2]byte{1, 0})) == 1
+
+// MappedTable is a LookupTable whose elements are those of an encoded table
+// file, mapped in memory read-only. Processes which map the same file share
+// its physical pages. On systems without memory mapping, or on big-endian
+// hosts, the table is read into memory instead.
+type MappedTable struct {
+	table LookupTable
+	data  []byte
+	seed  []byte
+	fp    [32]byte
+}
+
+// MapLookupTable maps the lookup table file at path, as written by
+// WriteLookupTableFile or by MarshalSeeded on a LookupTable. Before use, it
+// checks that the table is n-by-m, the checksum of the file, and that both
+// the fingerprint recorded in the file and the one of the table are those of
+// the matrix derived from seed by RandomMatrixFromSeed. Checking the
+// checksum reads the whole file once; the entries of the table which are not
+// elements of the matrix are only compared with the seed by Verify.
+func MapLookupTable(path string, seed []byte, n int, m int) (*MappedTable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() != int64(int(fi.Size())) {
+		return nil, fmt.Errorf("%w: file of %d bytes is too large", ErrBadEncoding, fi.Size())
+	}
+
+	data, err := mapFile(f, int(fi.Size()))
+	if err != nil {
+		return nil, err
+	}
+	t, err := newMappedTable(data, seed, n, m)
+	if err != nil {
+		unmapFile(data)
+		return nil, err
+	}
+	return t, nil
+}
+
+// MapSumhash512Table is MapLookupTable for the sumhash512 instance. The
+// returned table can be installed with
+//
+//	SetSumhash512Compressor(t.LookupTable())
+func MapSumhash512Table(path string) (*MappedTable, error) {
+	return MapLookupTable(path, sumhash512Seed, Sumhash512DigestSize/8, 8*(Sumhash512DigestSize+Sumhash512DigestBlockSize))
+}
+
+func newMappedTable(data []byte, seed []byte, n int, m int) (*MappedTable, error) {
+	h, err := parseEncodingHeader(data, encodingMagicTable)
+	if err != nil {
+		return nil, err
+	}
+	if h.n != n || h.m != m {
+		return nil, fmt.Errorf("%w: table of a %d-by-%d matrix, expected %d-by-%d", ErrBadDimensions, h.n, h.m, n, m)
+	}
+	if want := h.dataOffset() + 8*h.dataLen() + encodingChecksumSz; len(data) != want {
+		return nil, fmt.Errorf("%w: table file has %d bytes, want %d", ErrBadEncoding, len(data), want)
+	}
+
+	body := data[:len(data)-encodingChecksumSz]
+	if sum := sha3.Sum256(body); !bytes.Equal(sum[:], data[len(body):]) {
+		return nil, ErrChecksum
+	}
+
+	A, err := RandomMatrixFromSeed(seed, n, m)
+	if err != nil {
+		return nil, err
+	}
+	t := &MappedTable{data: data, seed: append([]byte{}, seed...), fp: fingerprint(A)}
+	if h.fingerprint != t.fp {
+		return nil, ErrSeedMismatch
+	}
+
+	elements := data[h.dataOffset() : len(data)-encodingChecksumSz]
+	t.table = make(LookupTable, n)
+	rowLen := m / 8
+	for i := range t.table {
+		row := elements[i*rowLen*256*8 : (i+1)*rowLen*256*8]
+		if nativeLittleEndian {
+			// m fits in 16 bits, so a row has less than 1<<13 entries.
+			t.table[i] = (*[1 << 13][256]uint64)(unsafe.Pointer(&row[0]))[:rowLen:rowLen]
+			continue
+		}
+		t.table[i] = make([][256]uint64, rowLen)
+		for j := range t.table[i] {
+			for b := range t.table[i][j] {
+				t.table[i][j][b], row = consumeUint64(row)
+			}
+		}
+	}
+	if fingerprint(t.table) != t.fp {
+		return nil, ErrSeedMismatch
+	}
+	return t, nil
+}
+
+// LookupTable returns the mapped table. It must not be used after Close.
+func (t *MappedTable) LookupTable() LookupTable {
+	return t.table
+}
+
+// InputLen returns the valid length of a message in bytes
+func (t *MappedTable) InputLen() int {
+	return t.table.InputLen()
+}
+
+// OutputLen returns the output len in bytes of the compression function
+func (t *MappedTable) OutputLen() int {
+	return t.table.OutputLen()
+}
+
+// Compress performs the compression algorithm on a message and output into dst
+func (t *MappedTable) Compress(dst []byte, msg []byte) {
+	t.table.Compress(dst, msg)
+}
+
+// Verify checks the checksum of the table file again, and that every entry
+// of the table is the one of the matrix derived from the seed. It costs about
+// as much as building the table.
+func (t *MappedTable) Verify(seed []byte) error {
+	if t.table == nil {
+		return errors.New("sumhash: table is closed")
+	}
+	body := t.data[:len(t.data)-encodingChecksumSz]
+	if sum := sha3.Sum256(body); !bytes.Equal(sum[:], t.data[len(body):]) {
+		return ErrChecksum
+	}
+	A, err := RandomMatrixFromSeed(seed, len(t.table), 8*len(t.table[0]))
+	if err != nil {
+		return err
+	}
+	if fingerprint(A) != t.fp || !t.table.derivedFrom(A) {
+		return ErrSeedMismatch
+	}
+	return nil
+}
+
+// Close unmaps the table. The table, and the LookupTable returned by the
+// LookupTable method, must not be used afterwards.
+func (t *MappedTable) Close() error {
+	if t.table == nil {
+		return errors.New("sumhash: table is closed")
+	}
+	t.table = nil
+	data := t.data
+	t.data = nil
+	return unmapFile(data)
+}
+
+// WriteLookupTableFile derives the n-by-m matrix from seed with
+// RandomMatrixFromSeed, and writes its lookup table to path, in the format
+// of MarshalSeeded, for MapLookupTable. The file is written to a temporary
+// file first, and renamed, so that processes never map a partial file.
+func WriteLookupTableFile(path string, seed []byte, n int, m int) error {
+	A, err := RandomMatrixFromSeed(seed, n, m)
+	if err != nil {
+		return err
+	}
+	b, err := MarshalSeeded(A.LookupTable(), seed)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0o444); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
+
+// WriteSumhash512TableFile is WriteLookupTableFile for the sumhash512
+// instance.
+func WriteSumhash512TableFile(path string) error {
+	return WriteLookupTableFile(path, sumhash512Seed, Sumhash512DigestSize/8, 8*(Sumhash512DigestSize+Sumhash512DigestBlockSize))
+}
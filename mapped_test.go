@@ -0,0 +1,173 @@
+package sumhash
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMapLookupTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sumhash512.table")
+	if err := WriteSumhash512TableFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, native := range []bool{true, false} {
+		func() {
+			defer func(saved bool) { nativeLittleEndian = saved }(nativeLittleEndian)
+			nativeLittleEndian = native
+
+			mt, err := MapSumhash512Table(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := mt.Verify(sumhash512Seed); err != nil {
+				t.Errorf("Verify: %v", err)
+			}
+			if !reflect.DeepEqual(mt.LookupTable(), sumhash512Matrix().LookupTable()) {
+				t.Errorf("mapped table differs (native %v)", native)
+			}
+
+			want := Sum512([]byte("mapped"))
+			h := New(mt, nil)
+			h.Write([]byte("mapped"))
+			if got := h.Sum(nil); string(got) != string(want[:]) {
+				t.Errorf("mapped table gives %x, want %x", got, want)
+			}
+
+			for _, c := range []Compressor{mt.LookupTable(), mt} {
+				if err := SetSumhash512Compressor(c); err != nil {
+					t.Fatal(err)
+				}
+				got := Sum512([]byte("mapped"))
+				allocs := testing.AllocsPerRun(10, func() { Sum512([]byte("mapped")) })
+				ReleaseSumhash512Compressor()
+				if got != want {
+					t.Errorf("installed %T gives %x, want %x", c, got, want)
+				}
+				if allocs != 0 {
+					t.Errorf("Sum512 with an installed %T allocated %v times, want 0", c, allocs)
+				}
+			}
+
+			if err := mt.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if err := mt.Close(); err == nil {
+				t.Errorf("closed twice")
+			}
+		}()
+	}
+}
+
+func TestMapLookupTableErrors(t *testing.T) {
+	dir := t.TempDir()
+	seed := []byte("mapped")
+	path := filepath.Join(dir, "table")
+	if err := WriteLookupTableFile(path, seed, 4, 256); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := 0
+	write := func(b []byte) string {
+		files++
+		p := filepath.Join(dir, "bad"+strconv.Itoa(files))
+		if err := ioutil.WriteFile(p, b, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	flip := func(i int) []byte {
+		bad := append([]byte(nil), b...)
+		bad[i] ^= 1
+		return bad
+	}
+	// the entry of the first row and byte of input for its lowest bit, which
+	// is an element of the matrix
+	bit := encodingHeaderSize + (len(seed)+7)&^7 + 8*1
+
+	tests := []struct {
+		name string
+		path string
+		seed []byte
+		n, m int
+		err  error
+	}{
+		{"rows", path, seed, 8, 256, ErrBadDimensions},
+		{"columns", path, seed, 4, 512, ErrBadDimensions},
+		{"seed", path, []byte("other"), 4, 256, ErrSeedMismatch},
+		{"truncated", write(b[:len(b)-1]), seed, 4, 256, ErrBadEncoding},
+		{"empty", write(nil), seed, 4, 256, ErrBadEncoding},
+		{"magic", write(flip(7)), seed, 4, 256, ErrBadEncoding},
+		{"checksum", write(flip(len(b) - 1)), seed, 4, 256, ErrChecksum},
+		{"entry", write(flip(len(b) - 100)), seed, 4, 256, ErrChecksum},
+		{"fingerprint", write(rechecksum(flip(40))), seed, 4, 256, ErrSeedMismatch},
+		{"matrix element", write(rechecksum(flip(bit))), seed, 4, 256, ErrSeedMismatch},
+	}
+	for _, test := range tests {
+		mt, err := MapLookupTable(test.path, test.seed, test.n, test.m)
+		if !errors.Is(err, test.err) {
+			t.Errorf("%s: got error %v, want %v", test.name, err, test.err)
+		}
+		if mt != nil {
+			t.Errorf("%s: table returned on error", test.name)
+		}
+	}
+
+	if _, err := MapLookupTable(filepath.Join(dir, "missing"), seed, 4, 256); !os.IsNotExist(err) {
+		t.Errorf("missing file: got error %v", err)
+	}
+
+	// A table whose checksum was recomputed after changing an entry which is
+	// not an element of the matrix is only found by Verify.
+	bad := rechecksum(flip(len(b) - 100))
+	mt, err := MapLookupTable(write(bad), seed, 4, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mt.Close()
+	if err := mt.Verify(seed); !errors.Is(err, ErrSeedMismatch) {
+		t.Errorf("Verify: got error %v, want %v", err, ErrSeedMismatch)
+	}
+}
+
+func TestSetSumhash512CompressorCorruptTable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sumhash512.table")
+	if err := WriteSumhash512TableFile(path); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b[len(b)-100] ^= 1
+	if err := ioutil.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MapSumhash512Table(path); !errors.Is(err, ErrChecksum) {
+		t.Errorf("corrupt file: got error %v, want %v", err, ErrChecksum)
+	}
+
+	if err := ioutil.WriteFile(path, rechecksum(b), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	mt, err := MapSumhash512Table(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mt.Close()
+	for _, c := range []Compressor{mt.LookupTable(), mt} {
+		if err := SetSumhash512Compressor(c); err == nil {
+			ReleaseSumhash512Compressor()
+			t.Errorf("corrupt %T installed", c)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+// Package merkle implements Merkle trees with sumhash512 as the node hash,
+// with single and batched inclusion proofs.
+//
+// A leaf is hashed as sumhash512(0x00 || leaf), and an internal node as
+// sumhash512(0x01 || left || right), so that leaves and internal nodes can
+// never be confused. At each level of the tree, the nodes are paired from
+// the left; when a level has an odd number of nodes, the last one is promoted
+// unchanged to the next level.
+package merkle
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-sumhash"
+)
+
+// Domain separation prefixes of leaves and internal nodes.
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+// HashSize is the size in bytes of the hashes in a tree.
+const HashSize = sumhash.Sumhash512DigestSize
+
+// Hash is the hash of a leaf or of an internal node.
+type Hash [HashSize]byte
+
+var (
+	// ErrEmptyTree is returned when building a tree without leaves.
+	ErrEmptyTree = errors.New("merkle: tree has no leaves")
+	// ErrIndexOutOfRange is returned when proving or verifying a leaf which is not in the tree.
+	ErrIndexOutOfRange = errors.New("merkle: leaf index out of range")
+	// ErrInvalidProof is returned when a proof is malformed or does not match the root.
+	ErrInvalidProof = errors.New("merkle: invalid proof")
+)
+
+// Tree is a Merkle tree over a list of leaves.
+type Tree struct {
+	// levels[0] holds the hashes of the leaves, and the last level holds the root.
+	levels [][]Hash
+}
+
+// HashLeaf returns the hash of a leaf.
+func HashLeaf(leaf []byte) Hash {
+	d := sumhash.New512Digest(nil)
+	d.Write([]byte{leafPrefix})
+	d.Write(leaf)
+	var h Hash
+	d.Sum(h[:0])
+	return h
+}
+
+// HashNode returns the hash of an internal node from the hashes of its children.
+func HashNode(left, right Hash) Hash {
+	d := sumhash.New512Digest(nil)
+	d.Write([]byte{nodePrefix})
+	d.Write(left[:])
+	d.Write(right[:])
+	var h Hash
+	d.Sum(h[:0])
+	return h
+}
+
+// Build builds the tree over the leaves.
+func Build(leaves [][]byte) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, ErrEmptyTree
+	}
+
+	level := make([]Hash, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = HashLeaf(leaf)
+	}
+
+	t := &Tree{levels: [][]Hash{level}}
+	for len(level) > 1 {
+		next := make([]Hash, (len(level)+1)/2)
+		for i := range next {
+			if 2*i+1 < len(level) {
+				next[i] = HashNode(level[2*i], level[2*i+1])
+			} else {
+				next[i] = level[2*i]
+			}
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+	return t, nil
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() Hash {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Size returns the number of leaves in the tree.
+func (t *Tree) Size() uint64 {
+	return uint64(len(t.levels[0]))
+}
+
+// Proof is an inclusion proof of a set of leaves in a tree.
+type Proof struct {
+	// TreeSize is the number of leaves in the tree. Verify checks it against
+	// the size known to the caller.
+	TreeSize uint64
+	// Indices are the positions of the proven leaves, sorted and without duplicates.
+	Indices []uint64
+	// Path holds the hashes of the nodes needed to recompute the root, which
+	// are not computable from the proven leaves. They are ordered by level,
+	// starting from the leaves, and by position within a level.
+	Path []Hash
+}
+
+// Prove returns an inclusion proof of the leaves at the given positions. A
+// single proof covers all of them, and shares the nodes of their paths.
+func (t *Tree) Prove(indices []uint64) (*Proof, error) {
+	known, err := normalize(indices, t.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	proof := &Proof{TreeSize: t.Size(), Indices: append([]uint64(nil), known...)}
+	for _, level := range t.levels[:len(t.levels)-1] {
+		width := uint64(len(level))
+		next := known[:0:0]
+		for k := 0; k < len(known); k++ {
+			p := known[k]
+			s := p ^ 1
+			switch {
+			case s >= width:
+				// p is promoted
+			case k+1 < len(known) && known[k+1] == s:
+				k++
+			default:
+				proof.Path = append(proof.Path, level[s])
+			}
+			next = append(next, p/2)
+		}
+		known = next
+	}
+	return proof, nil
+}
+
+// Verify checks that the leaves are at the positions proof.Indices in the
+// tree of treeSize leaves with the given root. leaves[i] is the leaf at
+// position proof.Indices[i], and the indices must be strictly increasing.
+//
+// The root does not commit to the number of leaves: as odd nodes are
+// promoted, the same path can be valid at several positions in trees of
+// different sizes. The caller must therefore know treeSize, and a proof for
+// another size is rejected.
+func Verify(root Hash, treeSize uint64, proof *Proof, leaves [][]byte) error {
+	if treeSize == 0 {
+		return ErrEmptyTree
+	}
+	if proof.TreeSize != treeSize {
+		return fmt.Errorf("%w: proof for a tree of %d leaves, expected %d", ErrInvalidProof, proof.TreeSize, treeSize)
+	}
+	if len(leaves) != len(proof.Indices) {
+		return fmt.Errorf("%w: %d leaves for %d indices", ErrInvalidProof, len(leaves), len(proof.Indices))
+	}
+	if len(proof.Indices) == 0 {
+		return fmt.Errorf("%w: no leaves to prove", ErrInvalidProof)
+	}
+	for k := 1; k < len(proof.Indices); k++ {
+		if proof.Indices[k] <= proof.Indices[k-1] {
+			return fmt.Errorf("%w: indices are not strictly increasing", ErrInvalidProof)
+		}
+	}
+	if last := proof.Indices[len(proof.Indices)-1]; last >= treeSize {
+		return fmt.Errorf("%w: index %d, tree size %d", ErrIndexOutOfRange, last, treeSize)
+	}
+	known := append([]uint64(nil), proof.Indices...)
+
+	// hashes[k] is the hash of the node at position known[k] of the current level.
+	hashes := make([]Hash, len(leaves))
+	for i, leaf := range leaves {
+		hashes[i] = HashLeaf(leaf)
+	}
+
+	path := proof.Path
+	for width := treeSize; width > 1; width -= width / 2 {
+		next := known[:0:0]
+		nextHashes := hashes[:0:0]
+		for k := 0; k < len(known); k++ {
+			p, h := known[k], hashes[k]
+			s := p ^ 1
+			switch {
+			case s >= width:
+				// p is promoted
+			case k+1 < len(known) && known[k+1] == s:
+				h = HashNode(h, hashes[k+1])
+				k++
+			case len(path) == 0:
+				return fmt.Errorf("%w: path is too short", ErrInvalidProof)
+			case p%2 == 0:
+				h = HashNode(h, path[0])
+				path = path[1:]
+			default:
+				h = HashNode(path[0], h)
+				path = path[1:]
+			}
+			next = append(next, p/2)
+			nextHashes = append(nextHashes, h)
+		}
+		known, hashes = next, nextHashes
+	}
+
+	if len(path) != 0 {
+		return fmt.Errorf("%w: path is too long", ErrInvalidProof)
+	}
+	if hashes[0] != root {
+		return fmt.Errorf("%w: root mismatch", ErrInvalidProof)
+	}
+	return nil
+}
+
+// normalize returns the indices sorted and without duplicates, after checking
+// that they are in a tree of the given size.
+func normalize(indices []uint64, size uint64) ([]uint64, error) {
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("%w: no leaves to prove", ErrInvalidProof)
+	}
+	sorted := append([]uint64(nil), indices...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	out := sorted[:1]
+	for _, i := range sorted[1:] {
+		if i != out[len(out)-1] {
+			out = append(out, i)
+		}
+	}
+	if out[len(out)-1] >= size {
+		return nil, fmt.Errorf("%w: index %d, tree size %d", ErrIndexOutOfRange, out[len(out)-1], size)
+	}
+	return out, nil
+}
+
+const proofVersion = 1
+
+// MarshalBinary encodes the proof as a version byte, the tree size as a
+// 64-bit integer, the number of indices as a 32-bit integer followed by the
+// indices as 64-bit integers, and the number of path hashes as a 32-bit
+// integer followed by the hashes. Integers are little-endian.
+func (proof *Proof) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, 1+8+4+8*len(proof.Indices)+4+HashSize*len(proof.Path))
+	b = append(b, proofVersion)
+	b = appendUint64(b, proof.TreeSize)
+	b = appendUint32(b, uint32(len(proof.Indices)))
+	for _, i := range proof.Indices {
+		b = appendUint64(b, i)
+	}
+	b = appendUint32(b, uint32(len(proof.Path)))
+	for _, h := range proof.Path {
+		b = append(b, h[:]...)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes a proof encoded by MarshalBinary. It checks that
+// the encoding is canonical, but not that the proof is valid.
+func (proof *Proof) UnmarshalBinary(b []byte) error {
+	if len(b) < 1+8+4 || b[0] != proofVersion {
+		return fmt.Errorf("%w: bad header", ErrInvalidProof)
+	}
+	treeSize := binary.LittleEndian.Uint64(b[1:])
+	n := uint64(binary.LittleEndian.Uint32(b[9:]))
+	b = b[13:]
+	if uint64(len(b)) < 8*n+4 {
+		return fmt.Errorf("%w: truncated indices", ErrInvalidProof)
+	}
+	indices := make([]uint64, n)
+	for i := range indices {
+		indices[i] = binary.LittleEndian.Uint64(b[8*i:])
+		if i > 0 && indices[i] <= indices[i-1] {
+			return fmt.Errorf("%w: indices are not sorted or contain duplicates", ErrInvalidProof)
+		}
+	}
+	b = b[8*n:]
+	m := uint64(binary.LittleEndian.Uint32(b))
+	b = b[4:]
+	if uint64(len(b)) != HashSize*m {
+		return fmt.Errorf("%w: bad path length", ErrInvalidProof)
+	}
+	path := make([]Hash, m)
+	for i := range path {
+		copy(path[i][:], b[HashSize*i:])
+	}
+
+	proof.TreeSize = treeSize
+	proof.Indices = indices
+	proof.Path = path
+	return nil
+}
+
+func appendUint64(b []byte, x uint64) []byte {
+	var a [8]byte
+	binary.LittleEndian.PutUint64(a[:], x)
+	return append(b, a[:]...)
+}
+
+func appendUint32(b []byte, x uint32) []byte {
+	var a [4]byte
+	binary.LittleEndian.PutUint32(a[:], x)
+	return append(b, a[:]...)
+}
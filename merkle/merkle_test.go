@@ -0,0 +1,254 @@
+package merkle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func testLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = []byte(fmt.Sprintf("leaf %d", i))
+	}
+	return leaves
+}
+
+func TestTreeVector(t *testing.T) {
+	tree, err := Build([][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := tree.Root()
+	expectedRoot := "77a0e5ce231ea2795e6b68fe8a50cd580e3982505a66e83e722d655ab57407145f63309d94c001955ebe423b1c0e0af22db93599be34f9fb35f34372fdf1f1bf"
+	if hex.EncodeToString(root[:]) != expectedRoot {
+		t.Errorf("unexpected root %x", root)
+	}
+
+	proof, err := tree.Prove([]uint64{3, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := proof.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedProof := "0105000000000000000200000001000000000000000300000000000000030000001" +
+		"6c6d3c14d82193cd4ded76937bac9a2956f7b24e0235fa0353d5f820a415f554d999e3be36738e265bdbed2e8b3f3f765641c0de8cb643dd69ea75b33247f2c0" +
+		"6dccfbb358aa1af7b7b4f55b26fc919bc8a1f1aa00b8a42332da25a37cac9f32479997a4321b849fc6538b5e5ea48fb09817fb6aca157bf5c41f096cb2a2750a1" +
+		"3b05c059ef3f746d0c556a47d94c429c8b37635acf84c2e46e1e9dc21e71cdf7ff61d8315c8b1343a9e15169766b24f49fd9947dcbdd553dec64d9daf19029"
+	if hex.EncodeToString(b) != expectedProof {
+		t.Errorf("unexpected proof encoding %x", b)
+	}
+}
+
+func TestTreeShape(t *testing.T) {
+	leaves := testLeaves(5)
+	tree, err := Build(leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := make([]Hash, len(leaves))
+	for i, leaf := range leaves {
+		h[i] = HashLeaf(leaf)
+	}
+	want := HashNode(HashNode(HashNode(h[0], h[1]), HashNode(h[2], h[3])), h[4])
+	if tree.Root() != want {
+		t.Errorf("unexpected root")
+	}
+
+	single, err := Build(leaves[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single.Root() != h[0] {
+		t.Errorf("root of a single leaf tree is not the leaf hash")
+	}
+
+	if _, err := Build(nil); !errors.Is(err, ErrEmptyTree) {
+		t.Errorf("got error %v, want %v", err, ErrEmptyTree)
+	}
+}
+
+func TestDomainSeparation(t *testing.T) {
+	l, r := HashLeaf([]byte("l")), HashLeaf([]byte("r"))
+	if HashNode(l, r) == HashLeaf(append(l[:], r[:]...)) {
+		t.Errorf("internal node hash equals a leaf hash")
+	}
+}
+
+func TestProveVerify(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 1; n <= 17; n++ {
+		leaves := testLeaves(n)
+		tree, err := Build(leaves)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var sets [][]uint64
+		for i := 0; i < n; i++ {
+			sets = append(sets, []uint64{uint64(i)})
+		}
+		for k := 0; k < 20; k++ {
+			var set []uint64
+			for i := 0; i < n; i++ {
+				if rng.Intn(3) == 0 {
+					set = append(set, uint64(i))
+				}
+			}
+			if len(set) > 0 {
+				sets = append(sets, set)
+			}
+		}
+
+		for _, set := range sets {
+			proof, err := tree.Prove(set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proven := make([][]byte, len(set))
+			for i, idx := range set {
+				proven[i] = leaves[idx]
+			}
+			if err := Verify(tree.Root(), tree.Size(), proof, proven); err != nil {
+				t.Fatalf("n=%d, indices %v: %v", n, set, err)
+			}
+
+			b, err := proof.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded Proof
+			if err := decoded.UnmarshalBinary(b); err != nil {
+				t.Fatal(err)
+			}
+			if err := Verify(tree.Root(), tree.Size(), &decoded, proven); err != nil {
+				t.Fatalf("n=%d, indices %v: decoded proof: %v", n, set, err)
+			}
+			b2, _ := decoded.MarshalBinary()
+			if !bytes.Equal(b, b2) {
+				t.Errorf("proof encoding is not canonical")
+			}
+
+			tampered := append([][]byte(nil), proven...)
+			tampered[0] = []byte("not a leaf")
+			if err := Verify(tree.Root(), tree.Size(), proof, tampered); !errors.Is(err, ErrInvalidProof) {
+				t.Errorf("n=%d, indices %v: tampered leaf: got error %v", n, set, err)
+			}
+
+			if len(proof.Path) > 0 {
+				bad := *proof
+				bad.Path = append([]Hash(nil), proof.Path...)
+				bad.Path[0][0] ^= 1
+				if err := Verify(tree.Root(), tree.Size(), &bad, proven); !errors.Is(err, ErrInvalidProof) {
+					t.Errorf("n=%d, indices %v: tampered path: got error %v", n, set, err)
+				}
+				bad.Path = bad.Path[1:]
+				if err := Verify(tree.Root(), tree.Size(), &bad, proven); !errors.Is(err, ErrInvalidProof) {
+					t.Errorf("n=%d, indices %v: short path: got error %v", n, set, err)
+				}
+			}
+			bad := *proof
+			bad.Path = append(append([]Hash(nil), proof.Path...), Hash{})
+			if err := Verify(tree.Root(), tree.Size(), &bad, proven); !errors.Is(err, ErrInvalidProof) {
+				t.Errorf("n=%d, indices %v: long path: got error %v", n, set, err)
+			}
+		}
+	}
+}
+
+func TestProveErrors(t *testing.T) {
+	tree, err := Build(testLeaves(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tree.Prove([]uint64{5}); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("got error %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if _, err := tree.Prove(nil); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidProof)
+	}
+
+	proof, err := tree.Prove([]uint64{1, 1, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Indices) != 2 || proof.Indices[0] != 0 || proof.Indices[1] != 1 {
+		t.Errorf("indices were not normalized: %v", proof.Indices)
+	}
+
+	var decoded Proof
+	b, _ := proof.MarshalBinary()
+	if err := decoded.UnmarshalBinary(b[:len(b)-1]); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("truncated proof: got error %v", err)
+	}
+	b[13], b[21] = b[21], b[13] // swap the indices
+	if err := decoded.UnmarshalBinary(b); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("unsorted indices: got error %v", err)
+	}
+}
+
+func TestVerifyIndices(t *testing.T) {
+	leaves := testLeaves(4)
+	tree, err := Build(leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := tree.Prove([]uint64{1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proven := [][]byte{leaves[1], leaves[3]}
+	if err := Verify(tree.Root(), 4, proof, proven); err != nil {
+		t.Fatal(err)
+	}
+
+	bad := *proof
+	bad.Indices = []uint64{3, 1}
+	if err := Verify(tree.Root(), 4, &bad, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("unsorted indices: got error %v, want %v", err, ErrInvalidProof)
+	}
+	bad.Indices = []uint64{1, 1}
+	if err := Verify(tree.Root(), 4, &bad, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("duplicate indices: got error %v, want %v", err, ErrInvalidProof)
+	}
+	bad.Indices = []uint64{1, 4}
+	if err := Verify(tree.Root(), 4, &bad, proven); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("index out of range: got error %v, want %v", err, ErrIndexOutOfRange)
+	}
+}
+
+func TestVerifyTreeSize(t *testing.T) {
+	leaves := testLeaves(3)
+	tree, err := Build(leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof, err := tree.Prove([]uint64{2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proven := [][]byte{leaves[2]}
+	if err := Verify(tree.Root(), 3, proof, proven); err != nil {
+		t.Fatal(err)
+	}
+
+	// The leaf promoted from a tree of 3 leaves has the same path as the
+	// second leaf of a tree of 2 leaves.
+	bad := Proof{TreeSize: 2, Indices: []uint64{1}, Path: proof.Path}
+	if err := Verify(tree.Root(), 3, &bad, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("proof for another size: got error %v, want %v", err, ErrInvalidProof)
+	}
+	if err := Verify(tree.Root(), 2, proof, proven); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("proof verified for another size: got error %v, want %v", err, ErrInvalidProof)
+	}
+	if err := Verify(tree.Root(), 0, proof, proven); !errors.Is(err, ErrEmptyTree) {
+		t.Errorf("empty tree: got error %v, want %v", err, ErrEmptyTree)
+	}
+}
@@ -0,0 +1,21 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package sumhash
+
+import (
+	"io"
+	"os"
+)
+
+// mapFile reads the first size bytes of f, on systems where it is not mapped
+// in memory.
+func mapFile(f *os.File, size int) ([]byte, error) {
+	b := make([]byte, size)
+	_, err := io.ReadFull(f, b)
+	return b, err
+}
+
+func unmapFile(b []byte) error {
+	return nil
+}
@@ -0,0 +1,85 @@
+package sumhash
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// pairTweak is XORed into the right child by CompressPair. It is the first 64
+// bytes of SHAKE256("sumhash512 pair").
+var pairTweak = func() (t [Sumhash512DigestBlockSize]byte) {
+	xof := sha3.NewShake256()
+	xof.Write([]byte("sumhash512 pair"))
+	xof.Read(t[:])
+	return t
+}()
+
+// CompressPair compresses two 64-byte digests into one, with a single call to
+// the sumhash512 compression function C:
+//
+//	CompressPair(left, right) = C(left || right XOR T)
+//
+// where T is a fixed 64-byte tweak. It is meant for the internal nodes of
+// Merkle trees, where it costs one compression instead of the three taken by
+// New512 on 128 bytes of input.
+//
+// Security. The map (left, right) -> left || right XOR T is a bijection, so
+// any collision of CompressPair is a collision of C on distinct inputs. C is
+// collision resistant under the hardness of the subset-sum problem on the
+// sumhash512 matrix (see the spec). This is the only property CompressPair
+// has: C is linear in the bits of its input, so CompressPair is not a PRF,
+// must not be keyed, and must not be applied to secret data.
+//
+// Domain separation. C has no spare input bits, so CompressPair cannot be
+// fully separated from the compressions of the padded mode of New512. The
+// tweak separates it from the first compression of New512, which has the
+// all-zero IV as its left half: CompressPair(0, r) is the chain value of
+// New512 after the block r XOR T, not after the block r. Trees must still
+// separate leaves from internal nodes themselves, for example by hashing
+// leaves with New512 over a prefixed input, and should not use the all-zero
+// value as a placeholder for missing children.
+func CompressPair(left, right [Sumhash512DigestSize]byte) [Sumhash512DigestSize]byte {
+	return compressPair(sumhash512Instance(), nil, &left, &right)
+}
+
+// CompressPairSalted is CompressPair in salted mode. The salt, which should be
+// 64 bytes, is XORed into the right child along with the tweak, as the
+// padded mode of New512 does with its input blocks:
+//
+//	CompressPairSalted(salt, left, right) = C(left || right XOR T XOR salt)
+//
+// Each salt selects a different function with the same security argument as
+// CompressPair. An all-zero salt gives CompressPair.
+func CompressPairSalted(salt []byte, left, right [Sumhash512DigestSize]byte) [Sumhash512DigestSize]byte {
+	if len(salt) != Sumhash512DigestBlockSize {
+		panic(fmt.Sprintf("bad salt size: want %d, got %d", Sumhash512DigestBlockSize, len(salt)))
+	}
+	return compressPair(sumhash512Instance(), salt, &left, &right)
+}
+
+func compressPair(inst *instance, salt []byte, left, right *[Sumhash512DigestSize]byte) (out [Sumhash512DigestSize]byte) {
+	var cin [Sumhash512DigestSize + Sumhash512DigestBlockSize]byte
+	copy(cin[:Sumhash512DigestSize], left[:])
+	block := cin[Sumhash512DigestSize:]
+	xorBytes(block, right[:], pairTweak[:])
+	if salt != nil {
+		xorBytes(block, block, salt)
+	}
+
+	if inst.table == nil {
+		return compressOnHeap(inst.c, &cin)
+	}
+	inst.table.Compress(out[:], cin[:])
+	return out
+}
+
+// compressOnHeap calls a compressor of the sumhash512 dimensions through the
+// Compressor interface, which makes its arguments escape. It is kept apart so
+// that the callers' buffers can stay on the stack.
+func compressOnHeap(c Compressor, cin *[Sumhash512DigestSize + Sumhash512DigestBlockSize]byte) (out [Sumhash512DigestSize]byte) {
+	dst := make([]byte, Sumhash512DigestSize)
+	c.Compress(dst, append([]byte(nil), cin[:]...))
+	copy(out[:], dst)
+	return out
+}
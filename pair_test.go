@@ -0,0 +1,99 @@
+package sumhash
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/sha3"
+)
+
+func TestPairTweak(t *testing.T) {
+	tweak := make([]byte, 64)
+	sha3.ShakeSum256(tweak, []byte("sumhash512 pair"))
+	if !bytes.Equal(tweak, pairTweak[:]) {
+		t.Errorf("got tweak %x, want %x", pairTweak, tweak)
+	}
+}
+
+func TestCompressPairVector(t *testing.T) {
+	var zero, salt [64]byte
+	for i := range salt {
+		salt[i] = byte(i)
+	}
+	a := Sum512([]byte("a"))
+	b := Sum512([]byte("b"))
+
+	tests := []struct {
+		out    [64]byte
+		output string
+	}{
+		{CompressPair(zero, zero), "ae22c1cf5e7bd0edf7b50746ed762eb94c2c184bb3d4ca0d07bc29d5c77a4b584f887625c7456cf24460adbec7999577f8c01135de937d799188d70b9c06f916"},
+		{CompressPair(a, b), "b2143ebb3978717bee49a7cfb5bb96d35be92edfd9496099bfaf919a69368148aff5d243324276195802cb4cb382fe8382e4fc0223e6621a20da3d435c54f858"},
+		{CompressPair(b, a), "972c2c766152e19711d551bd315da2f889f0aa601561c283b8cc2a49a955172c8f7bfb17d53e889fa836babace4014f145369b9f28bd5c15d20d771028beacc4"},
+		{CompressPairSalted(salt[:], a, b), "087a6ed859313e6987f78bbb737181a96027ed18216e889f154774e6d12e8aa1aa5ca472ced2bea94d9518d8366c2c3d2c160f099df4d8542e30ba1ad8010e2d"},
+	}
+	for i, test := range tests {
+		if hex.EncodeToString(test.out[:]) != test.output {
+			t.Errorf("test vector %d: got %x, want %s", i, test.out, test.output)
+		}
+	}
+}
+
+func TestCompressPair(t *testing.T) {
+	var left, right [64]byte
+	salt := make([]byte, 64)
+	rand.Read(left[:])
+	rand.Read(right[:])
+	rand.Read(salt)
+
+	A := sumhash512Matrix()
+	cin := make([]byte, 128)
+	want := make([]byte, 64)
+
+	copy(cin, left[:])
+	xorBytes(cin[64:], right[:], pairTweak[:])
+	A.Compress(want, cin)
+	if got := CompressPair(left, right); !bytes.Equal(got[:], want) {
+		t.Errorf("CompressPair differs from the compression function")
+	}
+
+	xorBytes(cin[64:], cin[64:], salt)
+	A.Compress(want, cin)
+	if got := CompressPairSalted(salt, left, right); !bytes.Equal(got[:], want) {
+		t.Errorf("CompressPairSalted differs from the compression function")
+	}
+
+	if CompressPairSalted(make([]byte, 64), left, right) != CompressPair(left, right) {
+		t.Errorf("CompressPairSalted with a zero salt differs from CompressPair")
+	}
+	if CompressPair(left, right) == CompressPair(right, left) {
+		t.Errorf("CompressPair is symmetric")
+	}
+
+	// CompressPair(0, r) must differ from the chain value of New512 after the block r.
+	var zero [64]byte
+	copy(cin, zero[:])
+	copy(cin[64:], right[:])
+	A.Compress(want, cin)
+	if got := CompressPair(zero, right); bytes.Equal(got[:], want) {
+		t.Errorf("CompressPair is not separated from the first compression of New512")
+	}
+
+	if n := testing.AllocsPerRun(10, func() { CompressPair(left, right) }); n != 0 {
+		t.Errorf("CompressPair allocated %v times, want 0", n)
+	}
+}
+
+func BenchmarkCompressPair(b *testing.B) {
+	var left, right [64]byte
+	rand.Read(left[:])
+	rand.Read(right[:])
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		left = CompressPair(left, right)
+	}
+}
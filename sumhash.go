@@ -3,22 +3,41 @@ package sumhash
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"hash"
+	"math/bits"
 )
 
-// digest implementation is based on https://cs.opensource.google/go/go/+/refs/tags/go1.16.6:src/crypto/sha256/sha256.go
-type digest struct {
+var (
+	// ErrBadSalt is returned when a salt does not have the block size of the compressor.
+	ErrBadSalt = errors.New("sumhash: bad salt size")
+	// ErrBadDimensions is returned when a matrix or a compressor has unusable dimensions.
+	ErrBadDimensions = errors.New("sumhash: bad dimensions")
+	// ErrBlockTooSmall is returned when the block size of a compressor leaves
+	// no room for the 16-byte length field of the padding.
+	ErrBlockTooSmall = errors.New("sumhash: block size too small")
+)
+
+// Digest is the state of a sumhash computation. It implements hash.Hash.
+// Its implementation is based on https://cs.opensource.google/go/go/+/refs/tags/go1.16.6:src/crypto/sha256/sha256.go
+type Digest struct {
 	c         Compressor
 	size      int // number of bytes in a hash output
 	blockSize int // number of bytes in an input block, per compression
 
-	h   []byte // hash chain (from last compression, or IV)
-	x   []byte // data written since last compression
-	nx  int    // number of input bytes written since last compression
-	len uint64 // total number of input bytes written overall
+	h     []byte // hash chain (from last compression, or IV)
+	x     []byte // data written since last compression
+	nx    int    // number of input bytes written since last compression
+	len   uint64 // total number of input bytes written overall, low 64 bits
+	lenHi uint64 // total number of input bytes written overall, high bits
+	nbits uint   // number of bits in x[nx], written by WriteBits
 
 	salt []byte // salt block
+
+	cin []byte // compression input, reused across blocks
+
+	outer bool // apply the outer hash of NewExtensionResistant
 }
 
 // New returns a new hash.Hash computing a sumhash checksum.
@@ -27,54 +46,249 @@ type digest struct {
 // the context returned by this function reference the salt argument. any changes
 // might affect the hash calculation
 func New(c Compressor, salt []byte) hash.Hash {
-	d := new(digest)
+	return NewDigest(c, salt)
+}
+
+// NewDigest is like New, but returns the concrete *Digest, which can be
+// cloned and reset with a different salt.
+func NewDigest(c Compressor, salt []byte) *Digest {
+	d := new(Digest)
 	d.c = c
 	d.size = d.c.OutputLen()
 	d.blockSize = d.c.InputLen() - d.size
 	d.x = make([]byte, d.blockSize)
 	d.h = make([]byte, c.OutputLen())
+	d.cin = make([]byte, c.InputLen())
 
-	if salt != nil && len(salt) != d.blockSize {
-		panic(fmt.Sprintf("bad salt size: want %d, got %d", d.blockSize, len(salt)))
+	d.ResetWithSalt(salt)
+	return d
+}
+
+// NewChecked is like NewDigest, but it validates the compressor and the salt
+// size, and returns an error instead of panicking.
+func NewChecked(c Compressor, salt []byte) (*Digest, error) {
+	if err := ValidateCompressor(c); err != nil {
+		return nil, err
 	}
-	d.salt = salt
+	if salt != nil && len(salt) != BlockSize(c) {
+		return nil, fmt.Errorf("%w: want %d, got %d", ErrBadSalt, BlockSize(c), len(salt))
+	}
+	return NewDigest(c, salt), nil
+}
 
-	d.Reset()
+// MaxParamsElements is the largest number of elements n*m of the matrix
+// accepted by NewFromParams. The lookup table of such a matrix takes 32 MiB.
+const MaxParamsElements = 1 << 17
+
+// NewFromParams creates a Digest for the sumhash instance whose n-by-m matrix
+// is derived from seed by RandomMatrixFromSeed. The parameters are validated
+// before the matrix is derived, and n*m must be at most MaxParamsElements, so
+// that they can be taken from an untrusted source.
+func NewFromParams(seed []byte, n int, m int, salt []byte) (*Digest, error) {
+	if n <= 0 || m <= 0 {
+		return nil, fmt.Errorf("%w: n=%d and m=%d must be positive", ErrBadDimensions, n, m)
+	}
+	if m%8 != 0 {
+		return nil, fmt.Errorf("%w: m=%d is not a multiple of 8", ErrBadDimensions, m)
+	}
+	if n > MaxParamsElements/m {
+		return nil, fmt.Errorf("%w: %d-by-%d matrix has more than %d elements", ErrBadDimensions, n, m, MaxParamsElements)
+	}
+	if m/8 <= 8*n {
+		return nil, fmt.Errorf("%w: input length %d is not larger than output length %d", ErrBadDimensions, m/8, 8*n)
+	}
+	if m/8-8*n < 16 {
+		return nil, fmt.Errorf("%w: block size is %d, must be at least 16", ErrBlockTooSmall, m/8-8*n)
+	}
+
+	A, err := RandomMatrixFromSeed(seed, n, m)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateCompressor(A); err != nil {
+		return nil, err
+	}
+	return NewChecked(A.LookupTable(), salt)
+}
+
+// ValidateCompressor checks that c can be used by a Digest: its input must be
+// longer than its output, and the difference, which is the block size, must
+// leave room for the 16-byte length field of the padding.
+func ValidateCompressor(c Compressor) error {
+	switch A := c.(type) {
+	case nil:
+		return fmt.Errorf("%w: nil compressor", ErrBadDimensions)
+	case Matrix:
+		if err := A.validate(); err != nil {
+			return err
+		}
+	case LookupTable:
+		if err := A.validate(); err != nil {
+			return err
+		}
+	case NibbleTable:
+		if err := A.validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.InputLen() <= c.OutputLen() {
+		return fmt.Errorf("%w: input length %d is not larger than output length %d", ErrBadDimensions, c.InputLen(), c.OutputLen())
+	}
+	if BlockSize(c) < 16 {
+		return fmt.Errorf("%w: block size is %d, must be at least 16", ErrBlockTooSmall, BlockSize(c))
+	}
+	return nil
+}
+
+// NewExtensionResistant returns a new hash.Hash computing a sumhash checksum
+// which, unlike New, is not subject to length extension. The salt is handled
+// as in New.
+//
+// The output of New on a message M is the chain value after the padding of M,
+// so from it and the length of M, anyone can compute the output of New on
+// M || padding || S for any S. Here the output of the padded mode is hashed
+// again, from the initial state, and with a domain separation byte in the
+// final block:
+//
+//	H'(M) = H_outer(H(M))
+//
+// Extending M then requires H(M), which is a preimage of H'(M) under the outer
+// hash. The domain separation byte ensures that H'(M) is not the hash of any
+// message under New. This costs two or three compressions per output.
+func NewExtensionResistant(c Compressor, salt []byte) hash.Hash {
+	d := NewDigest(c, salt)
+	d.outer = true
 	return d
 }
 
-func (d *digest) Reset() {
+// Reset resets the Digest to its initial state, keeping its salt.
+func (d *Digest) Reset() {
 	for i := range d.h {
 		d.h[i] = 0 // all-zeros initialization vector
 	}
 	d.nx = 0
 	d.len = 0
+	d.lenHi = 0
+	d.nbits = 0
 
 	if d.salt != nil {
 		// Write an initial block of zeros, effectively
 		// prepending the salt to the input.
-		zeros := make([]byte, d.blockSize)
-		d.Write(zeros)
+		for i := range d.x {
+			d.x[i] = 0
+		}
+		d.Write(d.x)
 	}
 }
 
-func (d *digest) Size() int {
+// ResetWithSalt resets the Digest to its initial state, and sets its salt.
+// If salt is nil, the Digest computes hash outputs in unsalted mode.
+// Otherwise, salt should be BlockSize(c) bytes. As in New, the Digest
+// references the salt argument.
+func (d *Digest) ResetWithSalt(salt []byte) {
+	if salt != nil && len(salt) != d.blockSize {
+		panic(fmt.Sprintf("bad salt size: want %d, got %d", d.blockSize, len(salt)))
+	}
+	d.salt = salt
+	d.Reset()
+}
+
+func (d *Digest) Size() int {
 	return d.size
 }
 
-func (d *digest) BlockSize() int {
+func (d *Digest) BlockSize() int {
 	return d.blockSize
 }
 
-func (d *digest) Write(p []byte) (nn int, err error) {
-	nn = len(p)
+func (d *Digest) Write(p []byte) (nn int, err error) {
+	d.checkLength(uint64(len(p)))
+	if d.nbits != 0 {
+		d.writeShifted(p)
+		return len(p), nil
+	}
+	return d.write(p)
+}
+
+// WriteBits adds the first nbits bits of p to the input. The bits of a byte
+// are taken from the least significant one, as the compression function and
+// the padding read them: bit i of the input is bit i%8 of p[i/8]. The other
+// bits of the last byte are ignored. Any number of bits can be written, and
+// the input need not be a whole number of bytes when it is hashed.
+func (d *Digest) WriteBits(p []byte, nbits int) {
+	if nbits < 0 || uint64(nbits) > 8*uint64(len(p)) {
+		panic(fmt.Errorf("bad bit count: %d bits in %d bytes", nbits, len(p)))
+	}
+	full, rem := nbits/8, uint(nbits%8)
+	d.checkLength((uint64(d.nbits) + uint64(nbits)) / 8)
+
+	if d.nbits == 0 {
+		d.write(p[:full])
+	} else {
+		d.writeShifted(p[:full])
+	}
+
+	if rem > 0 {
+		b := p[full] & (1<<rem - 1)
+		s := d.nbits
+		if s == 0 {
+			d.x[d.nx] = 0 // left over from previous blocks
+		}
+		partial := d.x[d.nx] | b<<s
+		if s+rem < 8 {
+			d.x[d.nx] = partial
+			d.nbits = s + rem
+			return
+		}
+		d.nbits = 0
+		d.write([]byte{partial})
+		d.x[d.nx] = b >> (8 - s)
+		d.nbits = s + rem - 8
+	}
+}
+
+// writeShifted adds the bytes of p to the input after a partial byte, which
+// must be there: they are shifted to follow its bits. It does not check the
+// length.
+func (d *Digest) writeShifted(p []byte) {
+	var buf [64]byte
+	s := d.nbits
+	partial := d.x[d.nx]
+	for len(p) > 0 {
+		n := copy(buf[:], p)
+		p = p[n:]
+		for i, b := range buf[:n] {
+			buf[i] = partial | b<<s
+			partial = b >> (8 - s)
+		}
+		d.write(buf[:n])
+	}
+	d.x[d.nx] = partial
+}
 
-	// Check if the new length (in bits) overflows our counter capacity.
-	if uint64(nn) >= (1<<61)-d.len {
-		panic(fmt.Errorf("length overflow: already wrote %d bytes, trying to write %d bytes", d.len, nn))
+// maxLenHi bounds the high 64 bits of the input length in bytes, so that the
+// length in bits is below 2^120, and the most significant byte of its 128-bit
+// encoding in the padding is zero. See the domain separation bytes.
+const maxLenHi = 1 << 53
+
+// checkLength panics if writing n more bytes of input would make the input
+// 2^120 bits or longer.
+func (d *Digest) checkLength(n uint64) {
+	_, carry := bits.Add64(d.len, n, 0)
+	if d.lenHi+carry >= maxLenHi {
+		panic(fmt.Errorf("length overflow: already wrote 2^64*%d+%d bytes, trying to write %d bytes", d.lenHi, d.len, n))
 	}
+}
+
+// write adds whole bytes to the input, which must be byte-aligned. It does
+// not check the length, so that it can also write the padding.
+func (d *Digest) write(p []byte) (nn int, err error) {
+	nn = len(p)
 
-	d.len += uint64(nn)
+	var carry uint64
+	d.len, carry = bits.Add64(d.len, uint64(nn), 0)
+	d.lenHi += carry
 	if d.nx > 0 { // continue with existing buffer, if nonempty
 		n := copy(d.x[d.nx:], p)
 		d.nx += n
@@ -95,8 +309,11 @@ func (d *digest) Write(p []byte) (nn int, err error) {
 	return
 }
 
-func (d *digest) copy() *digest {
-	dd := &digest{
+// Clone returns an independent copy of the Digest. It is useful for hashing
+// many messages that share a prefix: the prefix is written once, and the
+// Digest is cloned for each message.
+func (d *Digest) Clone() *Digest {
+	dd := &Digest{
 		c:         d.c,
 		size:      d.size,
 		blockSize: d.blockSize,
@@ -104,41 +321,190 @@ func (d *digest) copy() *digest {
 		x:         make([]byte, len(d.x)),
 		nx:        d.nx,
 		len:       d.len,
+		lenHi:     d.lenHi,
+		nbits:     d.nbits,
 		salt:      d.salt,
+		cin:       make([]byte, len(d.cin)),
+		outer:     d.outer,
 	}
 	copy(dd.h, d.h)
 	copy(dd.x, d.x)
 	return dd
 }
 
-func (d *digest) Sum(in []byte) []byte {
+const (
+	magic         = "sumhash\x01"
+	flagSalted    = 1 << 0
+	flagOuter     = 1 << 1
+	fingerprintSz = 32
+)
+
+// MarshalBinary encodes the state of the hash, so that it can later be
+// restored by UnmarshalBinary. The encoding records the compressor's
+// fingerprint, so a state can only be restored into a hash that uses an
+// identical compressor.
+func (d *Digest) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, d.marshaledSize())
+	b = append(b, magic...)
+	fp := fingerprint(d.c)
+	b = append(b, fp[:]...)
+	var flags byte
+	if d.salt != nil {
+		flags |= flagSalted
+	}
+	if d.outer {
+		flags |= flagOuter
+	}
+	b = append(b, flags)
+	b = append(b, d.salt...)
+	b = append(b, d.h...)
+	start := len(b)
+	b = append(b, d.x[:d.nx]...)
+	if d.nbits != 0 {
+		b = append(b, d.x[d.nx])
+	}
+	b = b[:start+len(d.x)] // already zero
+	// The length is stored in bits using 128 bits, as in the padding.
+	bitlen, bitlenHi := d.bitLen()
+	b = appendUint64(b, bitlen)
+	b = appendUint64(b, bitlenHi)
+	return b, nil
+}
+
+// bitLen returns the number of input bits written, as a 128-bit integer.
+func (d *Digest) bitLen() (lo, hi uint64) {
+	return d.len<<3 | uint64(d.nbits), d.lenHi<<3 | d.len>>61
+}
+
+// UnmarshalBinary restores a state encoded by MarshalBinary. The hash must
+// have been created with the same compressor as the encoded state. The salt,
+// if any, is taken from the encoded state.
+func (d *Digest) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic) || string(b[:len(magic)]) != magic {
+		return errors.New("sumhash: invalid hash state identifier")
+	}
+	b = b[len(magic):]
+	if len(b) < fingerprintSz+1 {
+		return errors.New("sumhash: invalid hash state size")
+	}
+	if fp := fingerprint(d.c); string(b[:fingerprintSz]) != string(fp[:]) {
+		return errors.New("sumhash: hash state was produced by a different compressor")
+	}
+	b = b[fingerprintSz:]
+	flags := b[0]
+	b = b[1:]
+	if flags&^(flagSalted|flagOuter) != 0 {
+		return fmt.Errorf("sumhash: unknown hash state flags %#x", flags)
+	}
+
+	want := d.size + d.blockSize + 16
+	if flags&flagSalted != 0 {
+		want += d.blockSize
+	}
+	if len(b) != want {
+		return errors.New("sumhash: invalid hash state size")
+	}
+
+	var salt []byte
+	if flags&flagSalted != 0 {
+		salt = make([]byte, d.blockSize)
+		b = b[copy(salt, b):]
+	}
+	h := b[:d.size]
+	x := b[d.size : d.size+d.blockSize]
+	b = b[d.size+d.blockSize:]
+	bitlen, b := consumeUint64(b)
+	bitlenHi, _ := consumeUint64(b)
+	if bitlenHi >= maxLenHi<<3 {
+		return errors.New("sumhash: hash state length is 2^120 bits or longer")
+	}
+
+	d.salt = salt
+	d.outer = flags&flagOuter != 0
+	copy(d.h, h)
+	copy(d.x, x)
+	d.len = bitlen>>3 | bitlenHi<<61
+	d.lenHi = bitlenHi >> 3
+	d.nx = int(bits.Rem64(d.lenHi, d.len, uint64(d.blockSize)))
+	d.nbits = uint(bitlen % 8)
+	if d.nbits != 0 {
+		d.x[d.nx] &= 1<<d.nbits - 1
+	}
+	return nil
+}
+
+func (d *Digest) marshaledSize() int {
+	size := len(magic) + fingerprintSz + 1 + d.size + d.blockSize + 16
+	if d.salt != nil {
+		size += d.blockSize
+	}
+	return size
+}
+
+func appendUint64(b []byte, x uint64) []byte {
+	var a [8]byte
+	binary.LittleEndian.PutUint64(a[:], x)
+	return append(b, a[:]...)
+}
+
+func consumeUint64(b []byte) (uint64, []byte) {
+	return binary.LittleEndian.Uint64(b), b[8:]
+}
+
+func (d *Digest) Sum(in []byte) []byte {
 	// Make a copy of d so that caller can keep writing and summing.
-	d0 := d.copy()
+	d0 := d.Clone()
 	hash := d0.checkSum()
+	if d.outer {
+		hash = d0.outerSum(hash)
+	}
 	return append(in, hash[:]...)
 }
 
-func (d *digest) checkSum() []byte {
+// outerSum hashes the output h of the padded mode again, from a reset state,
+// with domainOuter in the final block. It is used by NewExtensionResistant.
+func (d *Digest) outerSum(h []byte) []byte {
+	inner := append([]byte(nil), h...)
+	d.Reset()
+	d.Write(inner)
+	return d.checkSumDomain(domainOuter)
+}
+
+func (d *Digest) checkSum() []byte {
+	return d.checkSumDomain(0)
+}
+
+// checkSumDomain pads the input and returns the hash output. A nonzero domain
+// byte replaces the most significant byte of the 128-bit length, which is
+// zero for every input, see maxLenHi.
+func (d *Digest) checkSumDomain(domain byte) []byte {
 	B := uint64(d.blockSize)
 	P := B - 16
 
-	bitlen := d.len << 3 // number of input bits written
+	bitlen, bitlenHi := d.bitLen() // number of input bits written
 
 	// Padding. Add a 1 bit and 0 bits until P bytes mod B.
 	// The padding byte is 0x01 since sumhash reads bits in little-endian order.
+	// After a partial byte, the 1 bit follows its bits in the same byte.
 	tmp := make([]byte, B)
 	tmp[0] = 0x01
-	if d.len%B < P {
-		d.Write(tmp[0 : P-d.len%B])
+	if d.nbits != 0 {
+		tmp[0] = d.x[d.nx] | 1<<d.nbits
+		d.nbits = 0
+	}
+	if n := uint64(d.nx); n < P { // d.nx is the length mod B
+		d.write(tmp[0 : P-n])
 	} else {
-		d.Write(tmp[0 : B+P-d.len%B])
+		d.write(tmp[0 : B+P-n])
 	}
 
 	// Write length in bits, using 128 bits (16 bytes) to represent it.
-	// The upper 64 bits are always zero, because bitlen has type uint64.
 	binary.LittleEndian.PutUint64(tmp[0:], bitlen)
-	binary.LittleEndian.PutUint64(tmp[8:], 0)
-	d.Write(tmp[0:16])
+	binary.LittleEndian.PutUint64(tmp[8:], bitlenHi)
+	if domain != 0 {
+		tmp[15] = domain
+	}
+	d.write(tmp[0:16])
 
 	if d.nx != 0 { // buffer must be empty now
 		panic("d.nx != 0")
@@ -147,9 +513,23 @@ func (d *digest) checkSum() []byte {
 	return d.h
 }
 
+// Domain separation bytes. The modes built on top of the padded mode place
+// one of them in the last byte of the blocks they compress after
+// finalization, of the final block of an outer hash, or of the root block of
+// the tree mode. In the final block of the padded mode, this byte is the most
+// significant byte of the 128-bit length, which is zero as a Digest only
+// accepts inputs shorter than 2^120 bits. So these compressions never
+// coincide with the final compression of a hash.
+const (
+	domainXOFCounter = 0x80
+	domainXOFOutput  = 0x81
+	domainOuter      = 0x82
+	domainTreeRoot   = 0x83
+)
+
 // blocks hashes full blocks of data. len(data) must be a multiple of d.blockSize.
-func blocks(d *digest, data []byte) {
-	cin := make([]byte, d.c.InputLen())
+func blocks(d *Digest, data []byte) {
+	cin := d.cin
 	block := cin[d.size : d.size+d.blockSize]
 	for i := 0; i <= len(data)-d.blockSize; i += d.blockSize {
 		copy(cin[0:d.size], d.h)
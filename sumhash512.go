@@ -1,13 +1,25 @@
 package sumhash
 
 import (
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"hash"
+	"sync"
+	"sync/atomic"
 )
 
 // SumhashCompressor is a matrix derived from a seed which is used by the
 // sumhash512 interface. In order the gain speed, this matrix can be used to compress
 // input which have exactly size of InputLen()
-var SumhashCompressor Compressor
+//
+// The underlying lookup table is built on first use, rather than when the
+// package is loaded. SumhashCompressor is therefore no longer a LookupTable,
+// but forwards to the compressor of the sumhash512 instance; use
+// Sumhash512Compressor to get that compressor, and SetSumhash512Compressor to
+// replace it. SumhashCompressor must not be reassigned: only New512 and its
+// variants read it, while Sum512, CompressPair, the tree mode and SumMany use the instance.
+var SumhashCompressor Compressor = lazyCompressor{}
 
 // Sumhash512DigestSize  The size in bytes of the sumhash checksum
 const Sumhash512DigestSize = 64
@@ -16,12 +28,151 @@ const Sumhash512DigestSize = 64
 // of the sumhash hash function
 const Sumhash512DigestBlockSize = 64
 
-func init() {
-	matrix, err := RandomMatrixFromSeed([]byte("Algorand"), 8, 1024)
-	if err != nil {
-		panic(err)
+//go:generate go run gen.go -output sumhash512_matrix.go
+
+// sumhash512Seed is the seed from which the sumhash512 matrix is derived. The
+// derived matrix is checked in as sumhash512MatrixData, so that it need not be
+// computed at run time.
+var sumhash512Seed = []byte("Algorand")
+
+// sumhash512TableData is the precomputed lookup table of the sumhash512
+// instance. It is only set when sumhash512_matrix.go is generated with the
+// -table flag; otherwise the table is built from the matrix on first use.
+var sumhash512TableData *[8][128][256]uint64
+
+// instance holds the compressor of the sumhash512 instance. table is set when
+// the compressor is a LookupTable or a MappedTable, so that Sum512 and
+// SumSalted512 can hash on the stack.
+type instance struct {
+	c     Compressor
+	table LookupTable
+}
+
+var sumhash512 struct {
+	mu   sync.Mutex
+	inst atomic.Value // *instance
+}
+
+// sumhash512Instance returns the sumhash512 instance, building its lookup
+// table if needed.
+func sumhash512Instance() *instance {
+	if inst, _ := sumhash512.inst.Load().(*instance); inst != nil {
+		return inst
 	}
-	SumhashCompressor = matrix.LookupTable()
+
+	sumhash512.mu.Lock()
+	defer sumhash512.mu.Unlock()
+	if inst, _ := sumhash512.inst.Load().(*instance); inst != nil {
+		return inst
+	}
+	var table LookupTable
+	if sumhash512TableData != nil {
+		table = make(LookupTable, len(sumhash512TableData))
+		for i := range table {
+			table[i] = sumhash512TableData[i][:]
+		}
+	} else {
+		table = sumhash512Matrix().LookupTable()
+	}
+	inst := &instance{c: table, table: table}
+	sumhash512.inst.Store(inst)
+	return inst
+}
+
+// sumhash512Matrix returns the matrix of the sumhash512 instance. The rows
+// reference sumhash512MatrixData, so they must not be modified, nor handed
+// out to callers.
+func sumhash512Matrix() Matrix {
+	A := make(Matrix, len(sumhash512MatrixData))
+	for i := range A {
+		A[i] = sumhash512MatrixData[i][:]
+	}
+	return A
+}
+
+// Sumhash512Compressor returns the compressor of the sumhash512 instance. By
+// default, it is a LookupTable which is built on the first call, and is safe
+// for concurrent use.
+func Sumhash512Compressor() Compressor {
+	return sumhash512Instance().c
+}
+
+// SetSumhash512Compressor replaces the compressor of the sumhash512 instance,
+// for example with its Matrix, which is slower but much smaller. c must be a
+// Matrix, a LookupTable, a NibbleTable, an InterleavedLookupTable or a
+// MappedTable, and compute the same function as the sumhash512 matrix:
+// every element or table entry is compared with the matrix, which costs
+// about as much as building the table. Otherwise an error is returned. If c
+// is nil, the default lookup table is built again on next use.
+func SetSumhash512Compressor(c Compressor) error {
+	if c == nil {
+		ReleaseSumhash512Compressor()
+		return nil
+	}
+	if _, ok := c.(lazyCompressor); ok {
+		return errors.New("sumhash: SumhashCompressor forwards to the sumhash512 instance, and cannot replace its compressor")
+	}
+	if err := ValidateCompressor(c); err != nil {
+		return err
+	}
+	// The fingerprint also checks the dimensions.
+	if fingerprint(c) != sumhash512Description().Fingerprint {
+		return errors.New("sumhash: compressor does not match the sumhash512 matrix")
+	}
+
+	A := sumhash512Matrix()
+	var table LookupTable
+	var same bool
+	switch c := c.(type) {
+	case sumhash512ConstantTime:
+		same = true
+	case Matrix:
+		same = c.equal(A)
+	case LookupTable:
+		table, same = c, c.derivedFrom(A)
+	case *MappedTable:
+		table, same = c.table, c.table.derivedFrom(A)
+	case NibbleTable:
+		same = c.derivedFrom(A)
+	case InterleavedLookupTable:
+		same = c.derivedFrom(A)
+	default:
+		return fmt.Errorf("sumhash: compressor of type %T cannot be checked against the sumhash512 matrix", c)
+	}
+	if !same {
+		return errors.New("sumhash: compressor does not match the sumhash512 matrix")
+	}
+
+	inst := &instance{c: c, table: table}
+	sumhash512.mu.Lock()
+	sumhash512.inst.Store(inst)
+	sumhash512.mu.Unlock()
+	return nil
+}
+
+// ReleaseSumhash512Compressor drops the compressor of the sumhash512
+// instance, so that its memory can be reclaimed once no longer in use. The
+// default lookup table is built again on next use.
+func ReleaseSumhash512Compressor() {
+	sumhash512.mu.Lock()
+	sumhash512.inst.Store((*instance)(nil))
+	sumhash512.mu.Unlock()
+}
+
+// lazyCompressor is the value of SumhashCompressor. It forwards to the
+// compressor of the sumhash512 instance.
+type lazyCompressor struct{}
+
+func (lazyCompressor) Compress(dst []byte, msg []byte) {
+	sumhash512Instance().c.Compress(dst, msg)
+}
+
+func (lazyCompressor) InputLen() int {
+	return Sumhash512DigestSize + Sumhash512DigestBlockSize
+}
+
+func (lazyCompressor) OutputLen() int {
+	return Sumhash512DigestSize
 }
 
 // New512 creates a new sumhash512 context that computes a sumhash checksum.
@@ -33,3 +184,93 @@ func init() {
 func New512(salt []byte) hash.Hash {
 	return New(SumhashCompressor, salt)
 }
+
+// New512ExtensionResistant is like New512, but the returned hash.Hash is not
+// subject to length extension. See NewExtensionResistant.
+func New512ExtensionResistant(salt []byte) hash.Hash {
+	return NewExtensionResistant(SumhashCompressor, salt)
+}
+
+// New512Digest is like New512, but returns the concrete *Digest.
+func New512Digest(salt []byte) *Digest {
+	return NewDigest(SumhashCompressor, salt)
+}
+
+// Sum512 returns the sumhash512 checksum of the data, computed in unsalted mode.
+// It gives the same output as New512(nil). It does not allocate when the
+// compressor of the instance is a LookupTable, as by default, or a
+// MappedTable; after SetSumhash512Compressor installs another compressor, it
+// uses a Digest, which does.
+func Sum512(data []byte) [Sumhash512DigestSize]byte {
+	return sum512(sumhash512Instance(), nil, data)
+}
+
+// SumSalted512 returns the sumhash512 checksum of the data, computed in salted
+// mode. salt should be 64 bytes. It gives the same output as New512(salt), and
+// like Sum512, only allocates when the compressor of the instance is not a
+// lookup table.
+func SumSalted512(salt []byte, data []byte) [Sumhash512DigestSize]byte {
+	if len(salt) != Sumhash512DigestBlockSize {
+		panic(fmt.Sprintf("bad salt size: want %d, got %d", Sumhash512DigestBlockSize, len(salt)))
+	}
+	return sum512(sumhash512Instance(), salt, data)
+}
+
+// sum512 is a specialization of Digest for the sumhash512 parameters, which
+// keeps the whole state on the stack. It follows Digest.Write and
+// Digest.checkSum step by step. If the compressor of the instance is not a
+// LookupTable, it falls back to a Digest.
+func sum512(inst *instance, salt []byte, data []byte) (sum [Sumhash512DigestSize]byte) {
+	const B = Sumhash512DigestBlockSize
+	const P = B - 16
+
+	t := inst.table
+	if t == nil {
+		d := NewDigest(inst.c, salt)
+		d.Write(data)
+		copy(sum[:], d.checkSum())
+		return sum
+	}
+
+	if uint64(len(data)) >= (1<<61)-B {
+		panic(fmt.Errorf("length overflow: trying to hash %d bytes", len(data)))
+	}
+
+	var cin [Sumhash512DigestSize + B]byte
+	bitlen := uint64(len(data)) << 3
+	if salt != nil {
+		// An initial block of zeros, effectively prepending the salt to the input.
+		var zeros [B]byte
+		compress512(t, &sum, &cin, zeros[:], salt)
+		bitlen += B << 3
+	}
+
+	for len(data) >= B {
+		compress512(t, &sum, &cin, data[:B], salt)
+		data = data[B:]
+	}
+
+	// Padding. Add a 1 bit and 0 bits until P bytes mod B, then the length
+	// in bits using 128 bits.
+	var tmp [B]byte
+	n := copy(tmp[:], data)
+	tmp[n] = 0x01
+	if n >= P {
+		compress512(t, &sum, &cin, tmp[:], salt)
+		tmp = [B]byte{}
+	}
+	binary.LittleEndian.PutUint64(tmp[P:], bitlen)
+	compress512(t, &sum, &cin, tmp[:], salt)
+	return sum
+}
+
+func compress512(t LookupTable, h *[Sumhash512DigestSize]byte, cin *[Sumhash512DigestSize + Sumhash512DigestBlockSize]byte, input []byte, salt []byte) {
+	copy(cin[:Sumhash512DigestSize], h[:])
+	block := cin[Sumhash512DigestSize:]
+	if salt != nil {
+		xorBytes(block, input, salt)
+	} else {
+		copy(block, input)
+	}
+	t.Compress(h[:], cin[:])
+}
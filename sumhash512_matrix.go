@@ -0,0 +1,2072 @@
+// Code generated by go run gen.go; DO NOT EDIT.
+
+package sumhash
+
+// sumhash512MatrixData is the matrix of the sumhash512 instance, as derived by
+// RandomMatrixFromSeed([]byte("Algorand"), 8, 1024).
+var sumhash512MatrixData = [8][1024]uint64{
+	{
+		0xc0df2685a9f8a108, 0xd372dd7918bd1926, 0x81b0c917d8e8c5bf, 0x91c32989af25d88f,
+		0xe4fb6476ddac1fef, 0x1776c422f003baff, 0xe39485e3194e029c, 0x2f27f18138b755d7,
+		0x1ec8baba665f4efc, 0x02f9b1095f02fb80, 0x057769eaaa5aa414, 0xb568fb0ea60d0a45,
+		0xf62c54da635b9034, 0xd8d541749a109117, 0xb6a07ff972568a94, 0x7fbc62e23f01fd04,
+		0xc3af2dad3b365409, 0x4f9dc4ec9ae8441d, 0x86f440c5ce461c14, 0x49ac929edcb4e2dc,
+		0xfed6c2a89809d542, 0x7f8b5848caeb0943, 0xd59af15c3bf63403, 0xe5caba017b5e8dca,
+		0xa4810b8d4df4414d, 0x6f487da711542ad2, 0xa938d23079cc7292, 0x36833e2235c50b49,
+		0x7464913173de4ba3, 0xa59f1347ed15d104, 0x57807dd9b42fcd9e, 0x9a83f4228e52c8ac,
+		0x77a644cf11922986, 0x87180789feac6dcf, 0x885b1072fd17cc45, 0x8ab8072891adf6dd,
+		0x38046813ecdaae20, 0x3591564b5346bb7d, 0x0063914cbf80004e, 0xfe495ff343c3d876,
+		0xbf5837f8e486890f, 0xf55cc410cf63b761, 0x3f01e47e9d95f9c8, 0xb079fea42018f74d,
+		0xabf85cf8d4f8df24, 0x6a3948c4a6b040ad, 0xb97604bf73cf9e8d, 0xc114e265e4165a87,
+		0xe988dbe6253555db, 0x0e6c9fcb39f6c1d5, 0x2778dd0f225f6843, 0x32182f758512519c,
+		0x7349e7302bde5c3e, 0x454584e7902a1b35, 0xfd9baa8f566c8c47, 0xdb932d5a2194c084,
+		0x1ea337cd6c0a9785, 0xf3355c3620dce724, 0xb42314495b5ec05b, 0xfb00039c7e514934,
+		0xb75b4f5f1a4d89b7, 0xc11a0baff0166453, 0x7c5ac2b466bba409, 0x72eb08274a3e31d2,
+		0x2bf75260f93c0c03, 0x6785e8cdc378fa43, 0x790b69e33658097c, 0x0c0a108830fbba57,
+		0x87bd66e829aff7f7, 0x9f16d89b95e61d3f, 0x59a6c06ff0314049, 0xcb30596b38d3e4ca,
+		0xc2af25a6039a1b9d, 0xe3b385b0fd7088aa, 0xcbfc00ef460734c9, 0x869c0f03d84c4760,
+		0x6c84f2ff9f3efdaf, 0x5c43184d2b976204, 0x5e2b7b5e4805eb25, 0xa703e3e44a6a6194,
+		0xec8899e564611110, 0xf7ee30cf7901976a, 0x05aeb143db678b0f, 0xee45c7f9e5f48483,
+		0xb9ed5742789f40c0, 0x2cbab3c836fe1680, 0xb1b87930eeee9d2c, 0x97e558636e89d3e9,
+		0xc4e27c0f6ea5de78, 0x13236a5931f9ae70, 0x5b378d5573589d81, 0xbfc9394af4e6b75f,
+		0xa3177bc3be2fee93, 0xd4689b1c4f06d0e7, 0xdd2cba764bfb0f6a, 0x365b643e6b2f9dc2,
+		0x01e4c9ce697b3a07, 0x1bbd8b079069d3be, 0x252cfc581c70b13a, 0x7206e40a85d8510a,
+		0xe3bd6ceb7cabfce9, 0x947258d0779c9317, 0xc9b47459eb0e4a13, 0x3f137df56873b8c6,
+		0xc89db76f5c6a0f27, 0xcdbda16152dad129, 0x77b4cb7db286d1b4, 0xa4fdf7abe7db72c5,
+		0x1ab1765a141d6f44, 0x51117aefb5d5665f, 0x174ca960e377908b, 0x4d601be8c1315c18,
+		0x962932c5208a7781, 0xfec1511d1329bda8, 0x4aebb579372bb6d3, 0x5028b03ee13c010f,
+		0x73d31cfe4786528c, 0x851908400f7a7be9, 0x559cec3810d11da7, 0x60ca0e2331f9ce1a,
+		0xfb5d6f5b505a8b0c, 0xe70f849e88ff64c6, 0xfd5583bcf31adb79, 0xc814d8708e8d25bf,
+		0x09b4ad9fac34a098, 0x302c41fec4074ccc, 0x6db21e2cf9027927, 0xbfb2abeaa79929b5,
+		0x387d3974bead8e1f, 0x374a55a6e2381335, 0x8865cdeae3e93d88, 0xf165abe136383d21,
+		0xb6145d850dabf922, 0x236bb3e3b8e7f50b, 0x4d2e451d19f072aa, 0x01a04135d8601ea6,
+		0x2b1c6d1dcf3f25e5, 0xabc65f73524ce73e, 0xf511db392ad9326b, 0xae4f5fc4e387902c,
+		0x68fbdb638d8a737d, 0x207115808940ecd2, 0xcb1666f4e0a73be4, 0xfdf2d179fdc90bda,
+		0xd33090081389e470, 0xbc8f7bbdc61d5b87, 0x26709530861d4070, 0xf4dac5d1f78849bb,
+		0x5bb781bb4a8a8abe, 0x9dfa7bdff550eaf1, 0x6dd34256f5249cf5, 0xeb4d7fa886116ceb,
+		0x6dd02a64ec40b813, 0x200202ce97637f14, 0x85fc752c418dff40, 0xa311ea198380f9f6,
+		0x66767d6da320583a, 0x8fe27dd9935acbcf, 0xa5deb4c3f3a9877c, 0x3df2b6001197c067,
+		0x8018a35c6a8c9d2a, 0x33345aa0ce335d4e, 0xb093499bcd7fdfde, 0xa86966786847d52a,
+		0x6851dd5a351507af, 0xa3168a127bf44251, 0xf56ec5c563d2c55f, 0x3f9073b1f2a2fc69,
+		0x42305a6223067570, 0x61090dc37c7261fe, 0xae1c635e2e051969, 0x27770f67763e7593,
+		0xc412a1e9d71f47ca, 0x98a93fa1d7a5e082, 0x0dc089bf1d3bebbc, 0x1e7d01a5e81bcc9e,
+		0x05ac80c5c286d1a1, 0xe1933a40ebd4f989, 0x73324d2e7934eacd, 0x6817bfc16e3fd81f,
+		0x952b9ecea35252e8, 0xb2db439dbfe58c09, 0x623cd5cc2e4fc839, 0x28f4a9c71cfa13c8,
+		0xf364df30704bc3af, 0x8c656e1c419aa13c, 0x957fcdabed29eaaf, 0x6ef68de44d8158c4,
+		0xdb9aa1c0bd514d15, 0xdaff2c2ff9fb92a6, 0x125c00d4b7d6088d, 0x640cabcad3142d99,
+		0x134758f37232bb7f, 0x1b54ea82df209827, 0xa668dcc67a0e8dcd, 0x36c24d60d43ef369,
+		0xdbdf579db805a74b, 0x50afe6c3ad45e1d3, 0xd78d48ddc251fd54, 0xa0ea407cef226acb,
+		0x1ad76b832575f715, 0x7c68a750634fe534, 0x9d57d429d041943a, 0x000cb114842006e9,
+		0x8936f0ffe229c029, 0x0ae3f44973543eed, 0x80795acb791748ac, 0x2af4dcf63ad0931d,
+		0x0e2de99db0d1302f, 0x40ca2496e406a3dc, 0x511078b34d047da2, 0x7e1aa15e0d2cffc3,
+		0xc10cca7941e7415e, 0x94fedd27f3d6c616, 0x9e42ad76d6a7aa1a, 0x46e7e48f9a84f9b4,
+		0x2a91d9b556634fa2, 0xa14ce897e9e2cc69, 0xd4a16dadf43db15f, 0x212ec20c03e0d557,
+		0x937e007cdf559b15, 0x92f0a88c7c91c63f, 0x493412576ed07079, 0x399ec07a02f76c43,
+		0xfb64c3cb2f444db3, 0x647c99e4a178cd93, 0x698a176478efaafe, 0xa8d2304b0101413c,
+		0xbce6c996cb2424f8, 0xb30691974a436c81, 0x1f70dddf2c359fe0, 0x947ce1c42f4bdb82,
+		0xd9f86155639dfcd6, 0x0c5957546768f204, 0xa76c05bb06cd0571, 0x1d3d11962a392d1e,
+		0xb26c93d6a21107dc, 0x284e4f632bfce8a0, 0xb1f91c5cce13d52a, 0x027c3209e6e76fae,
+		0xe3497e244992a29b, 0x79c5d18131e78c96, 0x66ee0116dc7f4bc4, 0x46b2bf1671c2a36b,
+		0xf6a69c97516c645b, 0xbce98989616d30ef, 0xda1de853823afc76, 0x57074634accfb403,
+		0x644666b3e50a09fe, 0xca0350937cd88dad, 0xf71e9cc2c19e48d8, 0x8e99062c5a89d9b3,
+		0x1e131b17a7efdf92, 0xaa0074f4ad9e3662, 0x03eb1407a0a719b8, 0xf1dc05c4070c09f8,
+		0x64b45c7eb2e02d05, 0x2863f4170a1aac91, 0x716ba96eac8529c8, 0x0caa52f8c84657df,
+		0x4cad4edd287f0868, 0xa816ebc4b3d0a806, 0x73dee7dff8b7a122, 0x27ec56acc42e355c,
+		0x3951d77e6c5cb08a, 0x5c3c1d2c3ad792d8, 0xc93f6d42994253f0, 0x61217cbc3c32b68e,
+		0x604a03dc82d6966a, 0x236c39eb53a6e79f, 0xe6efd4cd7fc94b41, 0x3c9ce1df7335c16e,
+		0x0bba517b3be56521, 0x76e06fbc1ba1b0c4, 0xce571353610208a3, 0x3cb4993e8fdb2c94,
+		0xc4c750f5f63d025d, 0x33fd5522b7bd143a, 0x5c4bfa042002b1b1, 0x100db1d957e20900,
+		0xe5f115e9ec40ca06, 0x91bab4d8b4200d5b, 0x010f719661f353d2, 0x2c7746e938bc2c70,
+		0x6de89ca6f40bfa3e, 0xaa8466a4cea64283, 0x8b94756a8076cdbe, 0x2dfbe4843ba72efc,
+		0xf062edf2cf554f2a, 0xac7a352c52d66c5d, 0x7200dfe8e634b98b, 0x71a199a51c939d85,
+		0x136b45b820546f24, 0x3bb97ea99820f0eb, 0x896cbf53a3a601ec, 0xedc97bcf8a7c45cb,
+		0x648d526056b50586, 0x7a193b69514ee14f, 0x3f275aad6aa5438f, 0xdcaaca3a96b9523b,
+		0xc7229fb82bf29a80, 0xb7b4683b7cb8a707, 0x6ed4bf9c84bed330, 0x3db0e715f0653a86,
+		0xf32f8acbe6e34290, 0xdc3aa2da8f99890d, 0x5f6bfc75206e11de, 0x41a3527b0331f882,
+		0xaaa0c0506f7f67e1, 0xd127988062da5ed7, 0x5676e3b8c7b02d70, 0x782aab5cb29a4ce7,
+		0xbc7a47b8bf3e2f28, 0xe9d1e321e76f9331, 0xca9223273a847de0, 0x0f7149f4590b6cf1,
+		0x928dbdd769ac8b58, 0x5c913889636a9631, 0x0fd382be65ed6efb, 0xdf5ee01ef3d386ab,
+		0x6d5ab1f0eb6ab45a, 0xbd87a6fca70a3f98, 0x6d07dbf7eaf9cde1, 0xb2a02ca751ea73ba,
+		0x4eea52983ef39efe, 0x2475643dee1b20fb, 0x333cb386123b68d8, 0xe07d32b6d47ef973,
+		0x7fb38f8b48ed96dc, 0x0cdcae64fb305738, 0xaccabea8aaf56053, 0xcf4da8bc7204508d,
+		0xdeeb3308d457e614, 0xcf9157d1bbc2e483, 0xd6c27ea5290d27cf, 0x3ce7466dbd863214,
+		0x072594864c3eabdc, 0x2e15a8c28a63a9c1, 0xd179cf8f6443ff83, 0x6dc2b80c31fd64d3,
+		0x943fdb2ed127e81a, 0xf1676920d8311e73, 0x005a1445f0d3e4a1, 0xe99e88e860517672,
+		0xb7ade1efdd8c72f1, 0x67a3cf925a7fd876, 0xc061e488718691a3, 0x2e799ab0ac316050,
+		0x4b207627802f9239, 0x396b0c176a7d5cbb, 0x188ab8b179701243, 0xc6c948e90af4bef7,
+		0xf618800dd83fe046, 0x975511731bbdd9b2, 0x0ff402e509ee405a, 0x7e6a7c023a0fba58,
+		0xa9512d6de98e080c, 0x214a10255969f556, 0xd4b56b54cbf02be8, 0x955ae535bf0d428f,
+		0x764c0f71e9ca681c, 0x58be350f367fde08, 0x66c5a62e67ed4210, 0xb5f84b3de600ddca,
+		0x6e11b292da256188, 0x0e91c0febf29449a, 0x307814ac6d2c8153, 0x0ae99c48372b0bee,
+		0x30ad61c47b45367d, 0x254d4293feffeddf, 0x267b160c94194152, 0x47b7e71271e3fc2e,
+		0xdab4a05593fa85a4, 0x2055bc205db85770, 0x24247bb463801438, 0x66d1b98b91b5ca9a,
+		0x92023e60d51094db, 0x6d77b5b632119693, 0x58b40fdca227648c, 0xcefbfacf297343cd,
+		0x9d480f5eb2734274, 0x406c397f94a8191a, 0xaab583a34f3c1db4, 0xf1a1b6756abdb3cf,
+		0xc6e97c520df7ec5b, 0xaad3a9d0422a7acc, 0xdba426094176b9ee, 0x49813b964fd0246e,
+		0xe1e804f12f4faf76, 0x646c0dde8c745ce4, 0xf60393e7dbff194c, 0xcf66c344caf9339c,
+		0x31a947cc8c753aaf, 0xd08682b0c5b0018a, 0xe5006418b02bba7b, 0x7900331e063330cd,
+		0x6da09c140f5cec3f, 0x20b12b173caceaeb, 0xf41834006674a423, 0x3529513444d22a46,
+		0xa246880b188630ce, 0xbd2db662e0c1d151, 0x1d784600147f2bdf, 0x408a160c9854176b,
+		0x700ddb11f9aff69e, 0xeb543309f91a2c01, 0x4bf94fcc2d92fbf4, 0xc855ab1a3d236414,
+		0x7387221c3081cee8, 0xd1e5c07c8e9b1911, 0xef8f8926b6929fac, 0xf1d473f15ac96383,
+		0xcf77dfe746efb919, 0xd35e10c3cfc793b4, 0x64f3377fc58cfe71, 0xa26fcf1244b65534,
+		0x0c71346a2c823af2, 0x3b65bcd896ab219d, 0xea30c1bce4209786, 0x7f540ae2333aceb5,
+		0x1c73d3cfbf4e86f3, 0xb9682ca196e33a08, 0xcc2ab50fa2dbeb51, 0x8f05dae2eef9b214,
+		0xc5abad693fe208fb, 0x7c62b4a2603de5ae, 0x7b0782139639b005, 0xbab1c798b9782e27,
+		0xe0471518b3b32d45, 0x3ed54a367fe9670a, 0xb9dfbb5c10780307, 0x29c047f4119c7b8a,
+		0x90be2e27fed353bc, 0xdab5f6acd3e08bbe, 0x393592d7069ee709, 0x446e55fca89b1e0b,
+		0x44f18b0d3474ffd4, 0x08654369409df763, 0x53bc543153c04248, 0xb45ab57cd7826ebe,
+		0x12e628bd802ea576, 0xf92996db05da4d44, 0x546f6fc1a40d74d0, 0x6654dc56cd0a4eef,
+		0x0a845e667a244b9d, 0xadbe8a2304a894ce, 0xa126c1dc428d8355, 0xa1a78132d985ed44,
+		0x6ce80fad8654b7f8, 0xd295a405688b9c2e, 0x484937e76e015a40, 0xcc7e527c3e3073cf,
+		0x74ce603eab95c61a, 0x70209a64edf3bdf1, 0x0b3ecf616f44ba6f, 0x1588218a72ce719a,
+		0x3cac7f157879c401, 0xa3030fe290fab79f, 0x6ab7b9d34f11fafa, 0xf5ba810bf5b6635a,
+		0x0e3d278e9af9e98c, 0xf7525cee5711ca54, 0x16530baf222216f3, 0x9fc96559b68db187,
+		0x61a689e7be98c2d8, 0x03d50dea92c59555, 0xa6e18b8eec47c1a5, 0x7bc6ca1783ea6b54,
+		0x76444d38c2220b07, 0x5e3d33382127ceed, 0x5c4b8a271b88e1f9, 0x27dd08eed5715e05,
+		0x55db6dfaa9dd1e68, 0x6677b98203e348aa, 0x99c3ddef516db8ea, 0xd2838cc30c316e03,
+		0x3baaa6136a3eb2b8, 0x39e43f3ecea9b9ed, 0x49806c0ad170a8c9, 0xefd47ccc1acaf3e8,
+		0xf2f01aada8514840, 0x5a4940e03ddcf8f3, 0xd63eda82cb02a0c2, 0xf700eb7a351a9d38,
+		0x2ffe62c849c8e320, 0x432d76e3e09f6dab, 0x28e0082457da867d, 0x6db7aa3f73dd2d1e,
+		0xd8bdebb749ebc6e3, 0x94c4040fdf93560a, 0xb79d1fbc90ca71a4, 0x13ceaacb3eaccd3d,
+		0xbbc126d10a4076e7, 0x75e9d07b2f00c8d4, 0xcecff013ee87109d, 0x8feb1523c87a3b88,
+		0x4daf46904bdca993, 0x0c943c90385c0db7, 0x28921cca5b137818, 0x3395e3a2f003a33c,
+		0xf4d773b2cd496c25, 0xb78da77c50562bcd, 0x40a161efcf216591, 0x683a0a85a983f16a,
+		0x450e5f581f9846e3, 0xac36f0f3c6024838, 0xf98eadb7743b4604, 0x47b5c29ad354e265,
+		0xbf747591214eb31b, 0xff03ad85a34f8a31, 0x3bc159fa961b1a1d, 0x7d7e41562d64f9de,
+		0xf9f88131c9911559, 0x6bf9a660d9cfc17e, 0x32654ebd6fc29ba5, 0x6000e840d7a6046a,
+		0x2d5f1d77844c84fb, 0xe10bace30780e95c, 0xacf21b10b06da2b3, 0xba5e9735f598760e,
+		0x2845f5f0e17a737f, 0x738807e7f9fc15f0, 0x38266d0bfe31758b, 0x7667e696d8083377,
+		0x255b9a0d978805b4, 0x4e84094ac8631ce1, 0x166b609adbbeba86, 0xecdf19075dba14df,
+		0x504b357c315b2637, 0x768d708ac2800c46, 0x77b67c0cb27ebe32, 0x4f06db8dfe9ef6e1,
+		0x2d27b4ca9bb08d58, 0x9ecb36618a9f105d, 0xb75f411e7c8f22d1, 0x433e85e1826856eb,
+		0x7324eae4b0b1ae42, 0x0cdd1979a6b372cc, 0x8de0e6b6c02d1ea9, 0x0f5d6fe7fdd88ed6,
+		0xb1c01b260d8b36ec, 0x6b22f2c2a9f763fa, 0x33f90e1e2e0bca93, 0x88495702703a7ad9,
+		0x0f2a55f03c325026, 0xb79de898b87648e6, 0x58780e7bdc3076bb, 0xd25f771aba84fde2,
+		0x3d823efec4cd6e9f, 0x374efbbc4324703f, 0xb49c24bea8fce19d, 0x95afc085ba1585ee,
+		0x71edc32fc49590da, 0x6a2b7e8cd9e21e4f, 0x64502326ecd92ecf, 0xd1c37bd462bbf863,
+		0xfa612bd07f701df1, 0xbf96b55e6ecc6724, 0xd8db5e76e4eb3c69, 0xa68c0f14ea5d647c,
+		0x19f1ad174e4763ff, 0x719bc15fd992cb94, 0x4ce03d05bd2c848a, 0x3929a58a96adc07c,
+		0x80bd6371886126b2, 0x269e1c61f73444ce, 0xe1961037e0e814e6, 0xbfececdaa191478f,
+		0x59beaf775398f066, 0xd4a6c7920c16f53f, 0x97ad43e6516dcf58, 0x678f9287bca929a7,
+		0xa0be680f02fd4005, 0x15db07ee143bb557, 0xad4ec8873f122c20, 0x75e7058fc7fb7dc4,
+		0x391f87d39b0becbc, 0xca29b4f453aa116c, 0x0109017d2ff3c4a4, 0xc7c8c5b6f0d1a1e9,
+		0x006f32adb24241b9, 0x36153118ab7ed901, 0x72167b72ee648327, 0xc23ab85f90a24d52,
+		0x51cdca612752b3c2, 0x1fd24fb510831071, 0xe88f0c22c633d802, 0x6456ccd5a88bf5d2,
+		0xb6943324b6a0b62a, 0xdae98ea9a8d5421c, 0xa12191573b0455b7, 0x798c4fb35b775cc6,
+		0x11f53f0ce78c61a0, 0xea4bb2eb241dfede, 0xee6781056025d4f0, 0x7711b880610657f3,
+		0x0c9ddfcec85724bf, 0xf5e382aef54437d7, 0x5b2d1438af17ff6a, 0xb9cdcafc63e7daad,
+		0x13007dd9c3e6dc85, 0x32383b7cac9e4987, 0x19fdbafbcb97df4d, 0xdf2b4add5e829434,
+		0x6a99bf61e6470c1a, 0x46a808c316c0f0de, 0x000e6f5f41448c9e, 0xad7dab2270e97803,
+		0x34f13f8cd87ddd63, 0x7c193b5390bcbf33, 0x1d26efa9b8bca7d8, 0x098de479600cb758,
+		0x26cf5276c930ec88, 0x6a5d156a3f5fde27, 0x631b0bfb35d3a8c6, 0x2e00c98df5005c68,
+		0x797dc58860d13b7f, 0xf90279601a3820b2, 0x7d0fa6edcc48e8a9, 0x482f8b703f73936b,
+		0xf3f39a35337d94ec, 0xfba32c7cd137dcf1, 0x1d1341e83abf3ab5, 0x913a4bc9bbd27818,
+		0x88c763814e3c47ba, 0x236d0f90d93f7d87, 0xdca5eb028ef86f0a, 0xcbe4109d84c0fff4,
+		0x24e620ad53b825f6, 0x1acbda88bad20bac, 0x0f4833db2ef7b88d, 0xea33e39b480806e0,
+		0x214b7741626abe8f, 0xd4b3926d49756034, 0x88552ccd2141738a, 0xa5f4692d4baa0d9f,
+		0xb43d3444d2d4684c, 0x402b14f73fa83c61, 0x7037e389858bb4af, 0xd88723b4d2a15a9d,
+		0xab3abfba87e83e75, 0x64b668964c42f213, 0x4d4a5ba78793f050, 0x86860245eda7ba3b,
+		0x54fd9ac782e19a98, 0x3b4c1ee5909203a5, 0x68f934a16e58d5fe, 0x0b6901fefb4e045b,
+		0xf1f9a281e72c160c, 0xe1a88ecc0616dbd7, 0x9e8ac2259c7102e2, 0x34a91bad74e5472e,
+		0x5c09c8f37fb0d58c, 0x6cd9288f54d3600d, 0x509fd91774581b0b, 0x216b68451ed6c340,
+		0xb55246103aacc862, 0xb870a898c5fc79f6, 0x0cbeff2767ce6cb2, 0xa2743029ee68c9af,
+		0xb075b2431ef9a2d2, 0x1d309e0048ab8331, 0xfaa38c3ea629de55, 0xa86518630ec46f80,
+		0x4867062451f6012f, 0xa71d217f18adff8c, 0x8b2f8b05b7a2e20e, 0x252619337773c198,
+		0xfc23e69300d67934, 0x6e0e9a94ba15a7fd, 0x1daa5197c2d10f3e, 0x0d1f80592a711554,
+		0x3e68126f8da7d82e, 0xe20c777cb84f356a, 0x75bad28c350877b1, 0x53c8027784243176,
+		0x03f6ef306dc5f3ae, 0x94bbd781ef73d896, 0x4b06b703aca72759, 0xdfc99b1f96a39040,
+		0xc01a33b60a59a35d, 0x98b54cedeb95a3e1, 0xa76c4cae923fd098, 0x6823ff8c7ddf33cc,
+		0x2ef7fb0717e91807, 0x0761f1f52dc3719b, 0xc37b6e6ec0b1c228, 0xb04619006deb3b77,
+		0x68d13c09bf60ca3a, 0x3540ac0f44390a0e, 0x6e762af8ba1f0aba, 0x9af79e64cdb3a647,
+		0x66e55cd037b5fb93, 0x3b086f7b5c864348, 0x6ab353a2557d64d0, 0x0f27e1a386e5f0f4,
+		0xa547e1a3709cb5e4, 0x7a41c3e7c02642f1, 0x2f7ef311fb39936a, 0xfbca2e6ce35796ac,
+		0x940d75787fe0824a, 0x3e7cd87a054f0423, 0xcf2104f61380a1b4, 0x2a50502ee60cffa7,
+		0x23e5943296c00fb9, 0x7b8c6210cfd7562a, 0x2c29d2196b491cbd, 0x52316496f85fb9dd,
+		0x43fa8802cd17fc36, 0x61516f709e77e9dc, 0x648781fef35eaf5e, 0xbcec4182283fd91c,
+		0xb01238d6758eda66, 0x1e5faf23d075efd3, 0x9410f46311756cd6, 0x7fdbf49395d8cf1c,
+		0x70b83fde414b63ff, 0x97dbb84fd1da2909, 0x17438b81949fd564, 0x6fc1f5a9f2638540,
+		0xe5a051d21f0648db, 0xfe707083accee3d9, 0xba2952bc51e5ab3f, 0x6a87cc9d5aa2019f,
+		0x15c3338da0492c83, 0x4017d182cd62bdf4, 0x638e2c7f334f9a1f, 0xe892139f750c8d50,
+		0x9ba8fce0767d029b, 0x7aca1f6869fc141c, 0xa3c080482001bc81, 0x8cf4bc50f3e08045,
+		0x2760cdfd97a200ff, 0x0c77b6e64615c468, 0xbede55902d2e57ab, 0x5419a5e1555f416a,
+		0x5fb2c387baea9d93, 0x56f337ebddb69b0c, 0x873535469007b980, 0xca10ee89fb57a09c,
+		0xbf6ee33d0cfee289, 0xac5735ac4740f933, 0x8c42eb64ba13c2ce, 0x11aa4889d71f8673,
+		0x90d6f271b5cbd4ba, 0xb99736a435d4b79b, 0xef9e0da2e5cc7e22, 0xf23acc01550c4e16,
+		0x82b734d8cdd91e58, 0xe9e4ca711436f62d, 0xec76fd6fb988a836, 0x0e5e5134be3c0462,
+		0xa031eb7698c23bf9, 0x2cd73a7992f0351e, 0x78d5c4f45a0e1236, 0x431f0c6479fb16a6,
+		0xc98bafb5b4a8b298, 0xba6d64acb624a5d0, 0xf9c4d1f230ccc7cc, 0x1552a708a5027645,
+		0x5cddec2524685756, 0x768557173f1b657a, 0xef97db394033fd3c, 0x73cbc2befa224bdb,
+		0xb75caa19d74e3fdb, 0xa5e6da6d594e584e, 0xb0aa64a11595fd1c, 0xf70fafbac6e61046,
+		0x54d6f518e9616b06, 0x7630741e9fe1783a, 0xbecb11b2a49e3c0c, 0x99962fcbca7a917b,
+		0x1250343e8b0bf231, 0x7947e79e14f62ac8, 0x9b8467f3b356eeb1, 0xcc60de05181f1fe2,
+		0xa2b97c49f57722e7, 0xbffa9e18c292f9c4, 0x65c0b9e9d9173cae, 0xb2fdc62df9467c6b,
+		0xfc79f592ff3f27ac, 0xcbb949c1e5c4a117, 0x09461be2e9b049a5, 0x4f23a594434d3c17,
+		0x4535baa5309841e7, 0xdcfc55f27b7141ca, 0xef8276faab1d7ddf, 0x0a651c198aab4e2c,
+		0x155ee6831032284b, 0x25b41af4f969f893, 0x21880caac23ef5b4, 0x29f8207e77bcf6f5,
+		0x8b2d997496fda464, 0xd6e6d73f8fc5a797, 0xb65544c92fe0068c, 0xc526a6fe4abc4bb1,
+		0xb3be788c95c6778f, 0xfef37e7b1ffe82e9, 0x858705660179ac95, 0x4afb6c76e183847c,
+		0x14d6b8aa05c7da13, 0x4e47dc69fec7a4fe, 0x92e28207c63b0919, 0xe72c883aca85e90e,
+		0x407412b973004f14, 0xf46bcf7ab204b542, 0x4f4698e5fe28fe9c, 0x471deebb5e1c2e1e,
+		0xb2b13a976d5680b3, 0x3f5e5c8d52e9eb58, 0xdf497857f0b8cc70, 0xedbccb0fa84218c8,
+		0x51ec595232a815d8, 0x84bea9622985d6d1, 0x0f0681e1cc8c1e12, 0x1ce3a004a46a7b29,
+		0x035992fe9671e083, 0x662e8692e995b5f6, 0x90824232ca6499de, 0xf19c35c6dec2faec,
+		0x6830f2fd987369f6, 0xc5b58ba378480775, 0x2f68346d6086d78b, 0x48e2e8660b521a85,
+		0xdc7e413165ac545a, 0xee17e83ec8477ffe, 0x083344220bcea3f9, 0x23671a4f96fd0161,
+		0x44c66dd3be36217a, 0xd96e145d3fe282f1, 0xe9904d1b0a29d4f2, 0xfa223efc25b56a48,
+		0x4c36049b7cdc1293, 0xf53267a398feba76, 0xbefa19a0e5ffe4b2, 0x030817aa86c00f12,
+		0xd2a8213cab1bf842, 0x3a718473a3960b08, 0x430725373c413ecb, 0x83178756c519eb34,
+		0x1e994df5f49777a6, 0x0b082e2634ba1767, 0x459e07744905d16a, 0xcd57e3b8ee578949,
+		0xac1b053dd85ca60b, 0x06b8e7721c817ed4, 0x1a874e066caa6b1f, 0x05efd7eda1097e1b,
+		0x46088fd0f016a6b8, 0xc360cab830baeed3, 0x60b1cb066ecadcf9, 0x224a65c5bf59149f,
+		0xa0a806e2a19fcb16, 0xeeb85d4e410b5a75, 0xbf47548f099c760a, 0x48e00a72a60bdb77,
+		0xde9f1b0254c11412, 0xe9e61408b86e61fb, 0xee8efdea98d36a7c, 0xfe25199c94164559,
+		0x4e899cb2281c8668, 0x29dfcc1475a985f4, 0x4f9f09d88d2636e6, 0x550c6fc88ee153d9,
+		0x9155ab6fa168237c, 0x3abe5607b9f094be, 0x4ae2ab5caaab3a25, 0x698b09b5f0114133,
+		0xdd9c9b1d53f63b3c, 0xe9f665fd73c74b67, 0x3e70ac91f8515390, 0x9fbfff37f48b0099,
+		0xd5e445c7907f2fce, 0xdea8355641d9bcef, 0x683858515a2fc99b, 0x1878839a5d12bf2a,
+		0x7fdbba0be64cd28f, 0xdc0f0220cd31e994, 0xf3e93a123d14d506, 0x8a837a914639d880,
+		0xd15ff9c7336bb894, 0x1bcf428373435962, 0xf3fbe7642f55ad4e, 0x152badbe53d20699,
+		0xe5aad2e6be9313fb, 0xe9ad7b1f14cefe16, 0xc2e2b6cbfdb5fac0, 0x98cdc880b0a2e9d1,
+		0xf25377cb3fab9061, 0x75158fb8279aba65, 0x01d88e861aca2403, 0x4c6e667844cad614,
+		0x3ce23d1ab5242f8a, 0xfdb99929fd62315a, 0x3a944cc77a57b9ca, 0xe32333d1355f3de9,
+		0xf3eae81bf5e10333, 0x6b9a0399046bc6e9, 0xf46db69f4226433e, 0x8389e3404f83f203,
+		0x5e98ecfb0adc54f9, 0x8c943c7d026db057, 0x704cc2ff6f369f6a, 0x8450ee7f8c413df2,
+		0x987aeae5955a46ea, 0x91223076145f8d2d, 0x78d8c9fddf8586e2, 0xad2ce885c394fff1,
+		0x52aee4723e754f70, 0xe12d918ebbdb4a02, 0x664aedefa329b400, 0x5859f2ff42b9f827,
+		0x251d173cdaa9ba7f, 0x403a6e042ed5b613, 0x8a4d195c77c5c442, 0x0c914c29d6fe4fac,
+		0xa4eb02cda17fde46, 0x81888d395cc0453e, 0xcd48300861c15bfb, 0x0270c1cca135936d,
+		0xd79c9225d1f9a085, 0xed55962ca36ff6aa, 0xed2d93775ed2c0d4, 0xafc8745cbb213c4f,
+		0xdf0a3178bfd14c1c, 0x1486651be0d41a09, 0x7167ea4de120d0cb, 0x0cc1820cd9232461,
+		0x486cb95793c0bc0e, 0xc6908919459c12e3, 0x1cd8c53b99a1034f, 0xff02a78b93c88802,
+		0xc7321d82aa19488c, 0x28383dff8409b773, 0x680c4a5fdcc21662, 0xddc3dadee6827961,
+		0x6709794c1e43cefc, 0x5a0e92a59dd71e94, 0xdc4f8067cbc7a44b, 0x802506db05084f68,
+		0xa9b373a518b384ed, 0xb70669b342c4f630, 0x562c77b9836d0122, 0x813359d69cf475f6,
+		0x6397e82ac0c44406, 0x7ac197027c305ec5, 0xee26558c4c4a75af, 0xd211039b6936406f,
+		0x2c0c43cd356228aa, 0x4eb40888173b825c, 0x91daa980e1ff5092, 0x4c8536c05b26f0fa,
+		0x7272550c3a8f8f60, 0xf92a1f5ad9f37718, 0xfd6bf12f91b880a2, 0xacb446bef5ab77f5,
+		0x585714bd6ab191e8, 0x8b98f0be46dc902d, 0x6a2e6845801f2132, 0x37a3397cb93dc794,
+		0xc602fc02cbf04845, 0xd8c105a4c0e8415a, 0x0544974291131d7d, 0x82a9c22c01e85839,
+		0xa88775b0a08ff7ca, 0xb0628217ce4f420c, 0x46dc20177d780095, 0x909a9fef3e2b1274,
+		0xd6f449aee27e8e67, 0x69013c61953c8afa, 0xc5cf53173cde944d, 0x1675b993e824501a,
+		0xd5e45f376d5b230b, 0xfce16cc009ce0ed6, 0x31d63de1bf80d5a6, 0x96fcc10c42575e4a,
+		0xb8b4492ae06d5b3a, 0x2cadbe113b704bc6, 0xfeae1d214b63531f, 0x597aed24904faeca,
+		0x7a9eafbf931905c5, 0x78d01519e47b2d6f, 0xd893b616531d16a0, 0x858ba6ce33bc9a7c,
+		0x3b95a5cd30fbe673, 0xb471ce7068876899, 0xa77ab808694f8aea, 0xa2c1c16eae47d0ef,
+		0x95a7fef38ea98eea, 0x500a8e841fc94d02, 0x89639305d7aa3ab4, 0xe22d5f11fd3282f1,
+		0x294e5d9a927590f7, 0x3beada699ef82c0b, 0xb200ce881c1027c6, 0x676e42470c120293,
+		0x014243c1b2ec326b, 0x3f7f890eaa7ba219, 0xcce2bda3b709841c, 0xe6036c72ba9a24b7,
+		0xd963623f32ca08f2, 0x2b0641135ab15eec, 0x336cbdc0c9557c88, 0xf4972eceabbd1b41,
+		0x2137650b68a39c4b, 0x5206d4a7f6c514ed, 0x212ee7d15097f204, 0x5f050c1f14856363,
+		0x583a49df410de148, 0xb5859ae5e60cb221, 0x82df767cf3710d9b, 0x78335167c65927c5,
+		0xfbd9291b2a576830, 0xee86ae5a758c2034, 0x284a8b180a6f05a9, 0xd5652c5c41977e11,
+		0x67c489766febff57, 0x38c2d78c0fd34567, 0x4cbe5ef8969186bd, 0x727ac9b050fab0f7,
+		0xf66f0824d84041e3, 0x783a497ce5e0b87e, 0xa527ba9c3bed827c, 0x3eeca157b9d47590,
+	},
+	{
+		0xc8f90d5db2771d49, 0xa3439a40baaca277, 0x9434eee3a5349b4b, 0xed3ca209fc0fe3a3,
+		0xa36b42697c672898, 0xa13e3594f5b2308d, 0x7d974c68d4d3b357, 0x2955784063c14af7,
+		0x706c044ed52d88a9, 0x534ad7239db8215d, 0x1b1a5306c065b3e1, 0xecfc2b506dba08df,
+		0xc3dc4fcd61dcf946, 0xcc10bbca38c26d8d, 0x67969d1a8154220d, 0x2aeb64399414d4c1,
+		0xe5922698c55167d7, 0x980e8240e1c6e067, 0x73391ed69502e7c0, 0xa36a853cd1a68e9e,
+		0xbe105b4407f218a3, 0xc4cb9d17e677b0bb, 0x6683a39c3aca7e88, 0x236f716cab491253,
+		0x80a7dc8ff8c69668, 0x1c642631c8e487d3, 0xeb69fdbdf4f57fe8, 0x64a953b47733c1a9,
+		0x4a8bdfe8f46fba12, 0x6c225e25ad1c1e1e, 0xfdde80f89d6b4939, 0xa63dbec75729ebca,
+		0x5c2c5201707920f5, 0xb1172f83e3d7da44, 0x01d4d15db066eec1, 0xc636db43eceab31f,
+		0xa9a64cf19acc8a95, 0xa2c89364ef499782, 0xfb38861cacc871c8, 0x8c2881e48eb8b7ab,
+		0x27fc04651ab11a81, 0xfcc61df2197e8f4b, 0x5a711c7d9915c0b3, 0x8d49634b1958d266,
+		0xdc4d3bf01b85ea00, 0xed6d87ca0a347082, 0x0c511e432e4e8216, 0xbd3480e84f61d3ac,
+		0x067737a1cd6d873b, 0x6f96bb2ed0913d96, 0x04d9b146ed248ad0, 0xd786a8c16087a266,
+		0xf0b46d09ea7383ad, 0x865d12b541ef33a0, 0x5c035fb7d3c5a0b9, 0x193854834b8e44e5,
+		0x017a971761b8d2dd, 0x85a74ecf0d528397, 0x6c7e9562d53c1e52, 0xc44c4549cd80d1c3,
+		0xaf21750d33cb0105, 0x68c0e7f950cf5d49, 0xdba29d5e84fd1525, 0xc7b3efc3073422fa,
+		0x58abe7bb0f2632aa, 0xad958b0e502fa500, 0x1f052fb152175c87, 0x394565fde05e508a,
+		0xe4da9bad99c09705, 0xb329b8e3e0bb1b39, 0x30ad3cb7e1249e80, 0x63f60f1b608208ee,
+		0x16cbb5c847ec6982, 0xd4f6e8a1eed78b35, 0x20b97d0ec37e12ed, 0x27fc23847bce756b,
+		0xa184cd39d45503f6, 0x0962736a962b7365, 0x43b491d40faadb8a, 0xb50c5d7da4645605,
+		0xd8a18b8eb972cb6f, 0x3427fd76fd4ed909, 0x4e39aef96ec0263d, 0x51fef2b58951c848,
+		0xb470d320addc2b20, 0x2ac02f6c48fc302e, 0xe27001030ea234a6, 0x770d8877468f1a69,
+		0x85340b3b8aaaa1f0, 0x67ae64a73c39612d, 0x2260b81162d948ba, 0x9711028410f7be2e,
+		0x9f5b1c74bf630bff, 0x0af210a11b96df41, 0x83360dc45c4d76f7, 0x889837094d1a321e,
+		0x2fd56d4019253756, 0xdc0a4d8d78a2890b, 0x9162b5308432b8a9, 0x59ddd3be981b4fac,
+		0x4128ad0cb91b5396, 0x598bdb13a976552b, 0xd244d06429f52f9b, 0x968e8402ff38e1cf,
+		0xbb7a8cb453a00b38, 0x0817e94d01a9fe39, 0xce9bcf848edf4f8e, 0xbe11be90f44268df,
+		0xd66b0b23a9c7af98, 0x318f919336d80e67, 0x613beeed49c669a0, 0x12d71998fd7e883b,
+		0xe9a675762d0f2853, 0x23e9111ce2171cfc, 0xddf2a14758117e91, 0x150d0086688ba3cb,
+		0x59e909a81ea70144, 0xad140afe4d9d05c7, 0xd84cdaf6534b42bb, 0x76cfa24fdc20aff8,
+		0xd6dd359c0bcc92a0, 0x2c04026d58a95250, 0x3a0ebab7ae4d3747, 0xab1ca39a0e9fe503,
+		0x9c58773bdce3044c, 0x9be60c0d045c2288, 0x9b2f427dc6c348ac, 0xb971fa66fb2ff1c9,
+		0x9b32cb62a0943038, 0x8d0f311b07f419a6, 0x0edbafbec95db970, 0xf1d08d17cc6bbfbb,
+		0x1f07e94aaf48934c, 0xe66f418a92ba0c24, 0x4f9b9c9a679471d4, 0x60c2fe1659e88491,
+		0xb3cf63af98b298d9, 0xd88f37e275bb6cf5, 0x8dd55e92bf5975c0, 0xed51eff6f2a718ca,
+		0x6a5f3ebf096b9fb1, 0x18b1e47089dad3b6, 0x270c24d8e554e98a, 0xd1dfd4da42b52e39,
+		0xea149ba5600a7325, 0x841bcff348c3f4d9, 0xe1aeaf7d441c6870, 0x22e0c3657c03198a,
+		0x044af53b3db22e5b, 0x5c15b4c34686f2ad, 0x36ad91b5b9f18943, 0x07e28396ce0d5a5e,
+		0xd3227f16cc417785, 0xbd3959142a119652, 0x9b920b35cf6dc918, 0x60e6296ab57ac7ea,
+		0x3b06b9f3e32448a8, 0x73b36092f084385a, 0xc90c137005aa51df, 0xff31b03075b69a3a,
+		0x5e46bc5495a17274, 0x8f8918d0fd3a275d, 0x6a91f06826e03457, 0xcfbfa19c99f4b149,
+		0x0726f8e0c50979f5, 0x7c5c726c22495c03, 0x59a64aaf743f0196, 0x9aa3e79c4f4d03af,
+		0x5ebbe206d9368bae, 0xf4e8a72606fa2aba, 0x9900945b6c7a42a6, 0xd9b569b80e16fd9c,
+		0xc90709cda2596d81, 0x496e306f0234e23a, 0x9fbbfce24145b4b1, 0xc74ff78db11ad769,
+		0x2f65cb23d85da483, 0xe7e98e2a7c91675a, 0xe986348f7f583c5e, 0x1fa9bc5442b17949,
+		0x8333132cbea71054, 0xc541b10922dd161c, 0xc92901440e44f222, 0xfc25b3b8e2dfdf57,
+		0x2e5aa56b8f9669d6, 0xbda8b2ccee17c06d, 0x70b4ba4c3f9e5e7f, 0x8249f0e0a594a6d9,
+		0xe423d13f7828c178, 0x77370ec67b1fe313, 0xe5709844100bc7b8, 0xfa4b8053fabbb6a5,
+		0xc02ba3f4734382d2, 0x401c32988d91f5a1, 0xebf0ff2cf6e294b3, 0xbce22d037f493619,
+		0xebbf0e6513231c35, 0xdcbabe701b33394c, 0x502a2598975ce891, 0x89c708142a7e99d8,
+		0x52f806d68d995c6b, 0x08ae958c3d493802, 0x3ad69f7c453e80be, 0xf43f261e22512dcd,
+		0x2abfba94ae84f9a1, 0x491aaf0a9d73c575, 0x0b8723d0f87c60ca, 0x513b55e7fe347173,
+		0xc1dea7e66c058568, 0x8e9594c2f332b2a5, 0x5340c1df0ad4dedb, 0x4eeff0d5cd9036ed,
+		0x78feea5f77d864c4, 0xe8cd855439bf94bd, 0x1c2c80bd64df0c48, 0x5081f7316606f908,
+		0x075bd077c1f0e518, 0x912a46ac63ce14c2, 0x56af9a14384d29c5, 0x60e9e78971bb4854,
+		0xc1667d4f122988ee, 0x45cf22420311ae0a, 0x75265a31dc62c4c2, 0xc489558381bbd74a,
+		0xf6830580c2f4eb91, 0x4ff17abe6530c3ec, 0x7d9fbeaf9b5b111f, 0x6d029f4faec5dfee,
+		0xa044052ad76ed156, 0xfefbc69bbc3cf82b, 0xa3a297bcdcb366e1, 0xc12c13573d943aa7,
+		0x683a16c95ab8f72c, 0x923833595ef72a2d, 0xd402d1e57158b2b8, 0x9c4609af37eda19a,
+		0x0ae936ce2383fc54, 0x03676764c259f1a5, 0xf090a0068e84edb5, 0x40eafe6ac12f3f2c,
+		0xf12d2419fb0227a1, 0xd1876f92ed6d2bb2, 0x7e23c945b28c8e9b, 0x91ea7df2c719ff69,
+		0xe5a3a0fdd2624013, 0xfa899841314065d8, 0xf6f1feeac51dec37, 0x26edca125a9d931f,
+		0x4753092aca1df314, 0xf7a915c709142d61, 0x6c4302e96d44216e, 0x91ee0992bb0460c5,
+		0xe218dff69b9d4dc1, 0x21665fa099e3db75, 0x03d19809fd923008, 0x110e3e0df50cf00b,
+		0x1aacb777e4f149e3, 0xf334b32dc0d73b5f, 0xc72d3ea84a189167, 0x75127b909df85cef,
+		0x99b7adb85a09dd62, 0xe15b51ceee46dfc3, 0x71bcf08b408693b9, 0xa058deae489618c1,
+		0x01bddd6dd4730e4a, 0x56f24819b6a1943d, 0x4987d8faea4c30da, 0x597fddeb3b37f9a4,
+		0x8c1362eebd44d5f6, 0x0c965de03837a3fa, 0x60e7030799a41fcc, 0xa0da2589dfb1a9ca,
+		0xa6652dcf80ac1620, 0x448e66e600178ec7, 0x06ae84b7d2cf323c, 0xa9467a6ad8dc2a14,
+		0x682e43fdf05a3e57, 0x895659d0b72c44a2, 0x38770640c0f8f617, 0xa8d7325ba8ece0cc,
+		0xab61eb2020649cc9, 0x73e2b01af2bb85e5, 0x498120e0a5620d6f, 0x3604a65b19faa9d7,
+		0x2aea789db0216e58, 0xcdb0fce4b4d28a20, 0x5ede71fc74bea4f0, 0x41c9dabbfd6bba50,
+		0xc2716a20c58264ad, 0x978ea473169c3904, 0xc26b187d4ce2c593, 0x449100cd8fc9e0ca,
+		0xd8437d93ccf5cb4c, 0x873bf152c398a1a4, 0xb1d4bd59c85c0bd7, 0x07e1807a414998d9,
+		0x135fc8758b079f9d, 0x280dc70374d7342e, 0x92c0ee6442d6269f, 0xbc1aafe0c3fb35f8,
+		0xef251c4cedc033f3, 0x84c55d4971a2c5fe, 0xaecb86dca7524e41, 0x30735611d4fb3282,
+		0x25a9c41ffe9ee9b3, 0x75cd1d9a920ef472, 0xc83962218a174416, 0x44d35c0a917073c4,
+		0x79f556882e3267c1, 0xfe04e9ff28ee24db, 0x7f8a3ebee95e4d95, 0x9c09b863b1c39fad,
+		0xbc845087a40da807, 0x4ab969e2f6516a23, 0x870ed80cafb01359, 0x67b7c015eaff37a5,
+		0x480025489ef77840, 0xc3d06179dfe67bf0, 0x31fd178ff152859e, 0xe1a4d77f47505a2a,
+		0xf8ac2712bd1ee067, 0x9209f1d872ca438c, 0xaf40d21b654e9d97, 0xa8e8a2ad0207bc88,
+		0xad49647d8d54650a, 0xf791acd5b36ab5a9, 0x42592d8759f25341, 0x205e517d0ccc73df,
+		0x90e7c6844c84b8f2, 0x505754562f081810, 0xbe04b0e3efe5acd3, 0xa89f98c83556b3f3,
+		0xd686168956dd5bee, 0x5c04e4244b5c5f51, 0x8f1b6d5d5cfa2322, 0xd0607f7699cdc4af,
+		0x31ab5b10999bc37d, 0xb0805ab653984d19, 0xe2d06cf993f297c8, 0xc381739744790fb1,
+		0x78b99c368401a16f, 0x23099f75bca49080, 0x26621c928afb17cf, 0x75e2ca0d10baa5ef,
+		0xcc5c5b6f476ebffe, 0x810a8417b2ee53fe, 0xecc1612c863014a1, 0x2dfe253b22c4253d,
+		0x9ad3fa0d4f271101, 0xc9c5048e45d761f3, 0x5f40fb1a3ce3051f, 0xb9021196838685ba,
+		0x12e25fb818bb9544, 0xc11ad17fe16fc72f, 0xbc2d124510008a1d, 0x5ef88d32d06bc85b,
+		0x9d390b736f5f69ba, 0x3ab87d131c67d30d, 0x6c3689e30febea4a, 0x725722d611c17e9a,
+		0x1792e6b0a9ee7bc2, 0xcb42234dc0a5ee84, 0x77657b05eea6f794, 0x144cf2e4a6350c70,
+		0xf52c421127d5a59d, 0x6966221124deca9d, 0xd874804294f9bbdb, 0x7a478a39d56cf177,
+		0x44fc1d39eb916ef0, 0x6df0ec31abf133ef, 0xe4168f204c99fa55, 0x80ae9151c48b7964,
+		0x1d5675896b5c0af1, 0xf4008912651bff69, 0x91496e86656884bf, 0x1e57c67d80f2d6c3,
+		0xf01012ff16de24a1, 0xa1527ebfb31c32e6, 0x1b2b6f978a1dd4d6, 0x808abe498dc8a12c,
+		0x13803605e2a7c87c, 0x5b919f345d336cab, 0x63ba54c4e646d498, 0x2c443eeac89d48e8,
+		0xf0651ec00bc50614, 0x6540a7b80f31b228, 0xf3fcf79830652661, 0xf72caa071dd507e4,
+		0xd3417889f8e3b986, 0xc22d19981aa5a3ca, 0x207092274867a130, 0xef9f706ee1f63927,
+		0x0d70eb9f8aca7bb8, 0x3e65b0910f612fa8, 0xc0cca8ceed9ccf95, 0x000bb3cc385824f6,
+		0xe8401a52185e6d37, 0xded052546b266460, 0xd2630d20df79b94b, 0xc2f0bc465931b729,
+		0xe98227685281d355, 0x39b99e40d67f4c2d, 0x9388abb4a7c45b77, 0x06970c994d9396b7,
+		0xa65839b7d6d01077, 0x252d8f18f48ef683, 0x4e1d4d88459b18ef, 0x131339cdc9b8ab25,
+		0xdc410ab1d5ca1334, 0x57b9af6e06c0a48c, 0x8f4bccd3ebbea93e, 0x975ac761b84912a9,
+		0xfd4303b7aa25610b, 0x5462601b7c2dfbab, 0xc1bcbf0ac6109567, 0xc1905c51801c55ab,
+		0x25088dbb01e44acc, 0x309394fb5ec9a283, 0xe0d18b3a0e2df04d, 0xadb0e602a6523a16,
+		0xc3a75658dd76d47d, 0x404fd9f4eb526bb2, 0xd8ba2f20b77bf021, 0x34dbc707469b686a,
+		0x7c3e73d78ec5b79f, 0x2586fada70d6fe29, 0xafdc2c88c81f181e, 0x868954a178de5d50,
+		0x5b20375ad3b0d5f8, 0x760e3ce18e8c5dce, 0xdebbba793f8ef36f, 0x501f416dbab87378,
+		0xa125aa2ba755e8b6, 0x5c548afd74a0c12d, 0x044e2b6e57c0473d, 0x4eb3323e5810135a,
+		0xa0eee53e64c5e42b, 0x60a8d94731f99d56, 0xae68442507257d4b, 0x85b7505c98124fcf,
+		0xb28fea2501d03a62, 0xce3db93776049779, 0xa8d4600f3ea856d6, 0xf6237388db1489c9,
+		0xa7febf9acf57a831, 0xe060c47384dfc60f, 0x3d7aec48e4e5d265, 0x10ab0b7b1bd464d3,
+		0xe724239602ecaac4, 0x77b40ada04440b7f, 0x2fbe1bfbb7e7f1e1, 0x1826d2c764df9292,
+		0x2503403a3c9bb2c7, 0x13f431abad2ff77a, 0x9f23e2810868e198, 0xac871daf3f5a14f1,
+		0x616fa4318c27d82e, 0x2c8b1da3d2a8f2ed, 0x87fdb4e9080ee219, 0xa7d15ae4d4b554a5,
+		0x6d0a4024510843b5, 0x8ae614c22b5e597b, 0x48b7b7eecfe84e62, 0xcf163bda66e92a26,
+		0x9b6ab3636b245f8f, 0xfc156af9084dbabc, 0xed833edfad302363, 0xfd6d9253614606b0,
+		0xed2e0639fce7acd8, 0x830fd0ab4242767d, 0x1db94bcabb465651, 0x9e76841db08ba1ec,
+		0x6d344823d0779b0a, 0x570ac8729a3bf3aa, 0xacc26f8c21fded8b, 0xd5e833d1543bf8f5,
+		0xf088ba0e9a6633db, 0x8b592054a93620d7, 0x982f887ffea325ee, 0x3f0a83a99201ae8b,
+		0x800ff9146fbfafdc, 0xd070da7f53c73e3e, 0xd465f7b090cfea66, 0xca0c074ff3b09b4d,
+		0xe0587c0c497c52c3, 0x8264ed577c517bc5, 0xb40a420994cf74e8, 0x4b0bcc3f102e4600,
+		0xd09dbb6b578be950, 0xdc68d5531b2eb511, 0x58ba008e0ad78b36, 0xec06ad2c7f63e891,
+		0x69f80fa2c87136d6, 0xa3fe0b34d5ae7d07, 0x511c73c3e3bac7b5, 0x8334613d6088a342,
+		0x0ecab63f6127f8c3, 0x2f365675c7074bfd, 0x3ab34aac3e5e7afc, 0x65731eef53bef474,
+		0x344808a394843c06, 0x71e2fb8f4d3bbb08, 0x5718771a29fb441b, 0xf5122550d40cdf18,
+		0x0d86534615ab281a, 0x49035da28bf06dbb, 0x8843d19fb53a7d88, 0x5e662251a2a46432,
+		0xabe8179fd767c5a2, 0xa769fd58175542d3, 0x6a8702fc0919fc1d, 0x83a7bd1a540f0bd0,
+		0xb685fad638d15400, 0xf6c9f97f2db36003, 0x1767cd6c9fd43bb0, 0xd7d402adc87598a8,
+		0xc3bfd31b4473da09, 0xce24de044eddbd77, 0xd8b496cb78e0b933, 0xaa89958138cdfdf1,
+		0x6ce69a0d293a400e, 0x76ffb57aeb779ca7, 0x9f831f10007d2d99, 0x601ae0a9b63849d0,
+		0x8e7b3b2919f82d1f, 0x8da768caa3f9abe9, 0x9a3cd10cd43f9522, 0x6c578022ac30232b,
+		0xb648ddcea9cc0e56, 0xfc686587243c0fdf, 0x06829adb6e9871a0, 0x133f3b5ce6c72392,
+		0x141998b11409c5ac, 0xe1526c5b40e1053b, 0x7ea918f8baf0678e, 0x4e589a70ae9fad5e,
+		0x90942a2c62741159, 0x114b04b66d5b2c83, 0x969da12722e5e356, 0x0f336548e69c5099,
+		0x268e9471031e62cb, 0x1d2e9b87b3148791, 0xe5d55c00367d31aa, 0xf20fdf1fe2c05c7e,
+		0x6780b4526c3496f1, 0x7e390b159d4560d9, 0xe6c57343e0acd0f3, 0x338b4a741be49d20,
+		0xdfe728e243c27b72, 0xd1082b0e26334ce5, 0x71c2a2475016c4f5, 0xb783432591cd8b8b,
+		0x44fbffa727aea187, 0xf9021780145d3717, 0x9cb3060e4009f99f, 0x5ceb1f45e9d7c147,
+		0x7a97c1b02575811b, 0x3a2857951a06e3fa, 0x3068cda09420696e, 0xac52725c7c4c3d54,
+		0x38d1a786c4915679, 0xde7ef6f13add0fbc, 0x0ae905a0cfde43ae, 0x895686b799d38651,
+		0x0c860a5cdf22c296, 0x08d492ec4a8637cd, 0x69071c9c95984a0d, 0xaa09cb0c4f9aaa98,
+		0x5fd9328db9aec1a4, 0x2e2e8546a0d03ccd, 0x2f64ef4fe4a88502, 0x19ea89249da8fa81,
+		0x107e81be74f776a9, 0x7d7ccf38234154a9, 0x941d838c389442dd, 0x80c676c3ca4319a1,
+		0xc4d2b7053679f0cc, 0x95fe29245a6a75b6, 0x4fcdbea1f3ab4f2f, 0xe4466fb2a44c3118,
+		0xe62a383ae2be12f6, 0x82620c17a5ae99c9, 0x7dbc6052fe22e1c7, 0xa865b4c6b37392a4,
+		0x74f5211c43e8f34d, 0xa880c613d10535a2, 0xd7d436d7b49f8b9f, 0xf1eb0e19f2e32272,
+		0xd0e4ae750aba1b96, 0xd1faaf023864af11, 0xeae5d91152bbb8a7, 0xa5a0b7fe85f146f5,
+		0xd51edf583c98d8eb, 0x9af466e7ac0218d8, 0x2ed68cd3f80e9e3e, 0x630aa0ab4399a71b,
+		0xe410cfdb0de64136, 0x51e8731f3686c990, 0x6bf186f24251bad4, 0x0b6e9f319be4e637,
+		0x75ea5f368ee04a31, 0x93be5b36bd3141f6, 0xb0d39ef1f9b50d84, 0x63e8a498aa2619c9,
+		0xba0782745da5c5f6, 0xdb40f5972483962c, 0x43a62e7e2fa83f61, 0xa2bdc1d74c94b464,
+		0xb2d2854e024e93e2, 0x7b093979d654f0d5, 0x66761390603ef85f, 0x912428fe2da34ccd,
+		0xfb9b7f8149fe3a75, 0x8def4d3e01aa3733, 0x1ff5e6efd0332463, 0xa39195b5a91c9e92,
+		0x6c7d4d609650f016, 0xa3f155fb3828a8d4, 0x4f2bd5954fb0cd51, 0x5d41355b6ece282b,
+		0x98450b40b8c6fab1, 0x061506fdcac39642, 0xc6d08624d890c08f, 0x3e32e6b8cd98991f,
+		0x4244c88e8ba2d883, 0x800b370335d9a526, 0x0878a911640e68fc, 0xd345ec1b20da4dfb,
+		0xa65c55609d70f193, 0x86d936b81c2123f6, 0x81d699a68b578f1f, 0x5bd93412a902ed01,
+		0x57cc38c63a44fabc, 0xbe3c6d09b5799dc0, 0xb5d2afe77a18cb97, 0xc0f553ec2416fc5c,
+		0xa9e25af41e69c140, 0x5c934b7838d53265, 0x9ccaf14a600a2fa2, 0xcc59136e4a1cdfcc,
+		0xf57f640cc24faa6a, 0xf4a748292ced5182, 0xf9a65c418cd647d6, 0xb17d3498e4c53d1e,
+		0x123daa114bb5074b, 0x71659ef43bfd9e6f, 0x3981a331b96658d6, 0xea79120b47ca9830,
+		0x44a8fe1d52719c0e, 0x6db5b0200416efaa, 0xc79b8d3a57f036df, 0x3cacde380126354d,
+		0x31c9d47d376fcf83, 0x4e7edbf56bc45daf, 0x919ad448328651f4, 0xe79d15654c9e364b,
+		0x6163c9f90519b0e0, 0x03e11aae626e73ac, 0x54792dd236b9441c, 0xeb5e5a7f80aa2043,
+		0xa464b09da4d0c6bf, 0x341d6e097f4414a6, 0xb4d697d6706771be, 0xcc82749bb51426b8,
+		0x9130b9261b883ce4, 0x88c04c09ef2d04bc, 0xe86c6ae48cfb8231, 0x9a7ef2e99f6cb2c2,
+		0x733877615f56564c, 0xd30af8bfb15c3a36, 0x32528ecae3357bf6, 0x90b798ac1001d7cc,
+		0xdaa0856f999f3fed, 0x5815cbe27dcf221e, 0x28d16a5ec317a6bf, 0x528bde6770b4136b,
+		0x1fa5a3d55edcb8f0, 0x56576c5ebc651717, 0xc162a14291a376ed, 0xec8ca4b9987f8ad9,
+		0xb622d1a74381682d, 0x3bd79a9dfa7d8c6f, 0x7383307e46dae1d3, 0xdfd0f40f1d595262,
+		0xfcd997f6542fc9f2, 0x3d69350c2c230d0e, 0xb7384cffaa279928, 0xf485ff934da5f3ec,
+		0x1fcd7e55baed9b3d, 0x8eb9a14227993429, 0xd2fb025f10e58f0e, 0x14ace93318994262,
+		0x6eb2cdc46c8303c9, 0xdf666b2a8e7563b8, 0x6dac09f6db77275f, 0x6d8b91811d39ca0b,
+		0x8de4376a3cc222af, 0xb9381264f5f2e288, 0xdebc3fe96696e4c9, 0x46d4486c11d9416d,
+		0xec9a4c2e71f9319d, 0x3537489135402ecf, 0x0d22870d5a315463, 0xaff8bab0bb9a439e,
+		0xfb41be75c44bffec, 0xcd4b94c34ecc9fb9, 0xf5c558a5f874f19f, 0x651d7dcbf2a9fec6,
+		0xb04d549a466dc6e1, 0xcdb11ab59e33a303, 0xa2666991f825ad98, 0x15d676fc1fc6fcc9,
+		0x6309ae845d8ad0d0, 0x1f5ac5c02935a8c4, 0x28c01897446e9221, 0xf276445743591fa1,
+		0xb3c8870a0ec6cfb0, 0x35fe68cf1f01ec11, 0x046976ed6462bcb1, 0x5f8a71e9d1e03ab0,
+		0x486ecef0e8240596, 0x322efdcce07b5e42, 0x28e21c836eb72f36, 0x286adc257312d1a0,
+		0xe405592a71bb52c4, 0xe03147aec37a2e8b, 0xb609a5f4dc98d016, 0x4655085c479731e0,
+		0xc385bbf436fccebd, 0x1341ff103e115add, 0x0ed8141967de4c0b, 0x5681f3f213f223ac,
+		0x30acfbaacf22cce2, 0x707d561bbb8b43e8, 0x71e464cc5133a2e9, 0xf2fdc55cf554c893,
+		0xde2e45c4cada4819, 0x055c84bc384439cb, 0xb5557ccd859d27b6, 0x3ed7654694ac1eb8,
+		0xdab953fab254d1f9, 0x72ecf6104d6de095, 0x3e2e0621f1ee2c19, 0xa6afad73d5981894,
+		0x2030443d495f70bd, 0x3a3ffb85dc0f8b39, 0xbbc2fa72dca99fd1, 0x8b88c8565654f206,
+		0x35351830abba873e, 0xff80943450c46661, 0xf6dd0d73e200f703, 0x64782dc3ad0b8662,
+		0x74ba510436f90bb8, 0x3344c9a3f826a578, 0xc5d16c30e612cfaf, 0xb05be559ab3a3ad6,
+		0xefb8a7dfb19ddf35, 0xf04b9b4f682fa61d, 0xca8689eb62d365a9, 0xeb6fce942e48b9ff,
+		0xfca35e10e76aa2bd, 0xfe1e306fdbeb8a4a, 0x7e09d96dd86ff352, 0xd2e398485ff72cb3,
+		0x0ed56a74a3b243c4, 0xd70812de4997f3ad, 0xef0ccb9f92b4542c, 0x99c470cc9cb2d87a,
+		0xd12611a20ac45c3c, 0xe3ceef5288e104cd, 0x87b7daa65a7810ee, 0x9614bf9f7707043d,
+		0xfb4bd657fd94f5cb, 0x0d2871eb0bd2e6f5, 0xb017d8eff2fad7b3, 0xbe793c3a94da7a74,
+		0xa7a99250f6f84567, 0xc933eefd6a90eb84, 0x56e40cbaf0301367, 0xaa05b8b0a8a53aa2,
+		0xf1c00e41bf216e08, 0x9f8e859ec2287f0a, 0x71e735bd7d573de0, 0x15a367801a409b47,
+		0x816e6da42cac4b7f, 0x9d02db7f9a0e39e5, 0xe157ca751504fa82, 0x95add0ff9db386c8,
+		0x63e5671941874c1f, 0x2cf3ec20b0e16282, 0x926e7247bf20cf67, 0xc115b2a599bb4940,
+		0xd442daf5d093b504, 0x94ef106806eb7ce7, 0xcbc9ec743c59f5b4, 0x81aec2398fe5c92e,
+		0xa1c4625a7c2ad29c, 0x760a75f098e1c783, 0x6c14accc2c00683e, 0x2978d56017c320b6,
+		0x880e4c76cb1fac31, 0xc21032242cb1ac92, 0xbb0755edd9a3c26c, 0x90f92ef263fa7bf9,
+		0xff33bc428794aec5, 0x88a707c49907c4d5, 0x9e9a31200118cfc4, 0xfaaaf898003b0ea3,
+		0x21aa119219166886, 0x9aa1a021e2c2ca74, 0xc51c633340204427, 0x087ecb0faf28616a,
+		0x0966f8330e675768, 0xb9b56d4e4b10d208, 0x0ec925e03c0ec547, 0x92efc1a499690c3a,
+		0xbc68dd332f9646ad, 0xa69027fbd36ca733, 0x942eb3608cd7b8d0, 0x9dff69fbf8445cd4,
+		0x1e4eefc54e7ce4e2, 0xf89f16e2af3be1fb, 0x59783956fd70a96e, 0x16800409accaf1a8,
+		0x90287861f483d648, 0xe04b8bd03886446b, 0x599d8216f8142b7a, 0x73e8633f04d9bad4,
+		0x1fafbe88824a1072, 0x0c9da3939f1b978d, 0x34b68c35326d0fd6, 0x8922b5d1e2fb1949,
+		0x3dd94000eb0d6b3f, 0x97c0c0ccd54cef42, 0xdb03c0a644a944d3, 0x3a50c8e280c6c487,
+		0x9d1d0a0bac76044c, 0xa890d1f1cba8f215, 0x514f70d430ca1162, 0x97a22b970c036d94,
+		0x9dbdb5743d9ca891, 0xca9f3ca9ddea2ed0, 0x17106962a5075856, 0x0a9ab2c7e7991026,
+		0x8a7a5b56354e33a0, 0x6497cef40cd163d1, 0x7fa26983a758fcf7, 0x1981fb65407cd624,
+		0x78e59b84eaa34ccf, 0x7d3a7bfc5d4c8233, 0xdc5efd6381e7bd46, 0x636dbc45bb9b01f9,
+		0x5014cb7d7c44519b, 0x9721a39cd49b316a, 0x5ab6c029aae85d15, 0x1b0b3515eeb10fa8,
+		0x0af15e1c87401cb8, 0x5fc2206cd2bad373, 0x40a66d5d5ae887ee, 0x2deed15461fa8eff,
+		0x23723fa5cbb4b380, 0x9ab382d5d5016061, 0x443b0d23126676d5, 0xa648b590cddfe918,
+		0x7b24977870356c97, 0xa32077be81d2a01e, 0x9d867853e6c2eb2c, 0x0a0b9bf46407c8ed,
+		0x92a2b8bf6bbcfecb, 0x2dbc780249efa848, 0xb7c24c00ea00c9b3, 0x44db450a8d558c95,
+		0x809ce70d34eb2dbf, 0x9a7ca5242e682d3a, 0x1ca47ce5b227df17, 0x7c19d62bba0cbc83,
+		0x445bd10214969f01, 0x36e862b8f3b5dde1, 0x4a868e7ce78dd9f8, 0xd1df0d014edd9836,
+		0x5805736509b5aa2f, 0x741e9f290c4520a5, 0x603c7a066c493a79, 0x626fa795c8ffa3d7,
+		0x36d5d2ab7d7a4073, 0x93a617a2cb010609, 0xf292129358dc9c83, 0x029f21ad74d9d8bf,
+		0x4fc157f0854aa388, 0xe294edf90dcd5f10, 0xfa0b7aa03eac4ede, 0xb301baa963fd83ff,
+		0x079048f42561a750, 0x5eb1c7e45607d694, 0x9799ee2b4bb1ee70, 0x5b926f7f0c9f6540,
+		0xf6769936a8e3f9ac, 0x39e29f6539e47fb5, 0x8f12dcbdab4b353e, 0x52d73604e10de03d,
+		0x57b608841e984f65, 0xb3f799176b63552b, 0x8f0a5392d510d32e, 0xc83ace697819f295,
+		0x832480f611b6447c, 0xa0829f64171dec10, 0x3a82d3e5ce3fc320, 0x7e55800fe6981840,
+		0x66acbbf71181f331, 0xf64c401abe8ab71e, 0x3417a55f9826efff, 0x8c1697393c42822d,
+		0x498f26fde3481c9a, 0xcf0d7792832203a3, 0x71a62febaa385322, 0x9d9af2015f41bfc2,
+		0x1ca81cb3d0993697, 0x813cd725594f9ed8, 0xd61d13e87f75e8c9, 0x4e952e1700ffd52f,
+		0xfef0d75375e8d010, 0x02f6d7b13f446326, 0x9aa3a275ef621038, 0xd30e60895d42353e,
+		0x6404c31071701be4, 0x371ba4f8de57a26e, 0xec980776782215d6, 0xbc4abc12547c1dcc,
+		0xa32dc63026a8ed5c, 0x167fedf873aac86d, 0xb2d77c3cd093fb75, 0x90dd0f56c96d0516,
+		0x0499ca3f4712f76a, 0x745cbef5660e1f28, 0x337bea54718fcc05, 0x0377fe2aa5cffd5b,
+		0xda08a340e6c02870, 0xefd980e13ab91a16, 0x484d1583e2333f87, 0xe61db9fba897f3ef,
+		0x755c092d49fe2ec1, 0xe0fc0773ef2a8393, 0x2e1602eb017d5af6, 0x484d6d0d0255a1ab,
+		0xb57aec248045b61f, 0x02b3b9dac88f77f1, 0x01b8d7bf761075e0, 0x991c2e44a7853fc3,
+		0x5cb42b0d4fb1dc14, 0xc58eec7dbd07f78b, 0xbde223ba874a141f, 0xda55fc207b71e3cd,
+		0xc437838972fca4f6, 0xa774ff4f0fb9821e, 0xcbd584a8f0f3006e, 0x718b8061e962e9eb,
+		0x100c4df8173617bd, 0x0aba72ff009246cd, 0x183ebbfdf1f49f24, 0x0e3a69cf38044e41,
+		0x6fbba993e21e76eb, 0x851d5a8da7a6ff58, 0xa7e36ec6f6441d09, 0xafcbe8739a5ea89f,
+		0xe7645963bcee02fa, 0x8086e5a0259293a1, 0x0dadfb8f637176be, 0x8b4791d3fb7593c2,
+		0x46dd552467df3059, 0xe7b3d1be1639c6f6, 0x655aa99afcdd1362, 0x18f72f3407109453,
+		0x94082caaeab7e4cf, 0x693a52a2072b6b63, 0x846f5a06fa855a7c, 0x77626c0fc4034535,
+		0x8981d0c2de1c0bb3, 0xc22d36261e36f234, 0x1d9a3b70a55e3aaa, 0x24ad424b5e51b65f,
+		0xa8a89d85c53b14cc, 0x8e87e802b753d7a3, 0x500c92c697147e5b, 0x4ffc115a926f0897,
+		0x85ec8ec98160542e, 0x501a741443636725, 0x176eb9e89bd38769, 0x349fedc2aecb9651,
+		0x8efdfd7bccf53eb5, 0xcaeed3273ee523eb, 0xaee655ad8d1c4bb2, 0xa6a99fcc5c09db9b,
+		0x28d4f47081d0b2ea, 0x55b45dc90df7146d, 0xc3dbe73dc721c17e, 0xc4943377750bf917,
+		0xaeb9624240f4ab3b, 0x45b6e5052a279d55, 0x257cee5e35ca9762, 0x1c28729adade5911,
+		0x55ac6eeafbe19239, 0x79323339803b4872, 0x0a7c69d35e2bde10, 0xb7da0bd94e5b45a8,
+		0xe8a57ab5a58dfbc8, 0x37bf88664d07e987, 0xfae3f62a81182441, 0x2be8201c66373c5f,
+		0x98c2f5ebbceb355f, 0xa265fb0b2d87c061, 0xd87f033a8084d372, 0xdbf5d928ccd62d01,
+		0x8063c894eaa97d5f, 0x28f8680ce8b5106d, 0xdc943df2341d0e50, 0xf0309901759e4659,
+		0xa207e27387ea9222, 0x6c7bb136908782f0, 0xc59ff79933c87369, 0xa7b02c043c69f9c5,
+		0xf0385295e338b0d1, 0xadc0be9b1a8dbdfd, 0x75b5685098409cbd, 0x4ffe2a177e4ef655,
+	},
+	{
+		0x2c9a5428c0b824de, 0x8dfc2a2a64c1245e, 0x6d0c05ce1fcb4673, 0xdb733826ae555b6e,
+		0xcdcf5de3c3f5cc34, 0x61901669dcc97d23, 0xd5c737afaebe6b0d, 0x219397179ed2fc9c,
+		0xff447f3c00deebeb, 0x84b72fd74890a9b5, 0x2ae706fd5e595a53, 0x214adda4f50540da,
+		0x756e5b5404b3c80a, 0x28495afc415078c1, 0x1f83fe79cf8ee191, 0x47d039d1dc9bfa87,
+		0xf9351fa33b1d7799, 0x3d1a6bd27795de99, 0xd35aa03663339cd4, 0x754731bfedac3cfb,
+		0x67645e3663da03f0, 0xdcc9f759f3c85399, 0xa12d2c3d1ef642ce, 0x3c1feb020c0b1094,
+		0x57cd58953ed6efb3, 0x4414f199cdb6ed4c, 0xaab1adaed50d8527, 0x38a9dbc56ef0b824,
+		0x97741f638ba672e8, 0x7d1023200e287bce, 0xbd14e6129f30bd5b, 0xb41e979b5b234b83,
+		0xccb091c287fe4673, 0xa857cfd267a07ff6, 0xfe03c1ed1a5ea9ae, 0x8a750a479b6bd499,
+		0xf5b94e51771a9fe2, 0x6fb896f7d235568a, 0x83bc1dde7556cce7, 0x52449f03a373805a,
+		0x9a3d7549624a412e, 0x60a62be61db3a234, 0xc0092829ba42ffe5, 0xcb240af131bdaa0c,
+		0x1b5812c95e3f8478, 0x5227e0f598a5340d, 0x20fa79c1c5686edd, 0x3a914c85e06a246c,
+		0x40952b9945b3d267, 0x3857767759fb8ec6, 0xa88a9e66225da268, 0xec8685fe4fe010a9,
+		0xe00c6a383bafdbd2, 0x0af7ba50f2283060, 0xb079019e6c884f76, 0xc691aac55569ce14,
+		0x6eff218a436f819a, 0x3f98c5512e8139e3, 0x349be5c49f211312, 0x242bb0f848a58ad0,
+		0x97b9cbc21cc4813a, 0xe8fb9279e098b086, 0x2210ff9a5afbefb6, 0x3144780c505b51bd,
+		0x8523334b41c93e0b, 0x60231bf17f8de502, 0xafc5e90fd40a2fe8, 0x417216148db38e18,
+		0x27f061e82c57d088, 0x0efaf4a302b1dae0, 0x1ee755b291561082, 0x94b41c520ab2d5ce,
+		0x7c7241592ea31489, 0x30ec24750ac1c7c9, 0xc1a85f666348824c, 0xfce5f8f6665d58cb,
+		0x69b71ed823164122, 0x74420394f2890cb4, 0xa48ff314f92d22b2, 0xf3b4309484725d0e,
+		0x59d9b760ae773546, 0x808fb4b74278fd72, 0x9884b1b78fbdce0c, 0x179a3cd0ca6e128e,
+		0xede0634d250b084a, 0x577654161d222448, 0x65bfdafc14310791, 0x17cbb97247c2e8ce,
+		0xaa10cce6234b3aa3, 0x362162db39ac9fda, 0x2d551f0511091e0c, 0x47e7547d8660e6f5,
+		0x09f4a020438e57d5, 0xc79bd10dd81dae0b, 0xef92ef3c48a29cfe, 0xf92dcee73f1268fe,
+		0x973f451855cb7f32, 0x5249b938031ef36b, 0x032506e3288668b6, 0x724ba2b17d12ab6c,
+		0xaa08c6b9c06bc964, 0x3e74c0913666ddd5, 0x5af4d63709050dc2, 0x07d23af7b4b7268d,
+		0x8135ff65cd43b52e, 0x9fd90cf402efba6d, 0x6acf816fc6ca1b30, 0x46b72a323da06dc9,
+		0xf8cd935d26e8b6ad, 0xdd53b82083d55df3, 0x179b7f1a3b9f2b83, 0x132635facd968c6c,
+		0xaf5fe5d5b9512502, 0x41b4481cedec5ae6, 0x72219de3312dec1a, 0xc04685e1882ada6d,
+		0xd1a6f4b9b16cf2eb, 0xc6579a09e186a5ca, 0xbabd88bd56bb5792, 0xbda1b226d4be0643,
+		0x394248073131c2e1, 0x8f444856b0293eae, 0x6db7f73dc9fdc397, 0xe6ba64301bd6fac6,
+		0x308efb6f716f3edc, 0x2c6573658712ef1b, 0x26350d21b9405e1f, 0x0bf6b7869aba85d5,
+		0xaa14254f40ca7acc, 0xeb5d3e008762d701, 0xb9772ce17924023b, 0x3e82e4fdfe79fe53,
+		0x25ae32c0e0b3a145, 0xe106d8d58ec03049, 0x719fa0ebf8a73da1, 0xd15f5f5970693300,
+		0x1303c12618fa0c4f, 0x1dba645b4296cf24, 0x2a4c6e655d7517aa, 0x388b0a72b9620e8b,
+		0x16b3bf91c21a6de6, 0xecaedbfbf31b6962, 0x5a90a609e1d057e9, 0xf9603074fe6c7926,
+		0x0c6fc4d4a89d01b4, 0x25c01d0d57935820, 0x0d0bb6b59463bfef, 0xa309eadc26620b62,
+		0x73c379fd3b6eebaa, 0x868b6ea12964c73b, 0xe5900025209fe8bd, 0xe1f461d9bbef24c5,
+		0xaf256f5a4e3f82a5, 0x24e87b74a1eec2ec, 0x91563e55ec6bb399, 0xa1d84939f2dcfb99,
+		0xcf1e187e8d32a9eb, 0xdfb15a2e72afcca8, 0x6686150ee2a1f8c5, 0x9f0b16c47c296bf3,
+		0xb8cdfe145b17d9ff, 0xefd06268da328bf2, 0x11e5d8a8454074b3, 0xc9336db9e107acd7,
+		0xcc0b0828aea02b6a, 0xc1658a32f4479f64, 0x2bdf4ac255e75a58, 0xd26a752c9f4fc8ca,
+		0xf9b5a47540167d06, 0x97c0ae1d5708aed8, 0x040df28c0b0dc2e5, 0x0d2faade7c79b1b8,
+		0xcd7e0d6befc5b3b5, 0x933f3e22e4973851, 0xa1e980ffd0a953e2, 0x3933f6acb9785b5b,
+		0x0b41ac42bf3b99fb, 0x66333b01c6215e69, 0x1ebfb4cf92568e83, 0x2d88b544b2af3ff8,
+		0xaaa8b36a76ea4c4b, 0x66bcff1f2da6fcba, 0x0e330902b164bcf7, 0x8b391de45cd15f92,
+		0x2486691a7c676acc, 0xd8bb2a45d18f0364, 0xc89fff3b0c771cee, 0x5c83287ba07814f8,
+		0x840d40fde6bbb9c4, 0x19128b73892e7df8, 0x421895165cb06f39, 0x8ce9a1b616c2b122,
+		0x1d2b7cff65f7ddf7, 0x3093af724fc9d05b, 0xedc8c2de4789bf64, 0xc176ccfe0fee385d,
+		0x301f0553e86ea7a6, 0xd04443d029932771, 0xa60744d391bf41fb, 0xbe096790f734280d,
+		0x92c6ae8452b4f88a, 0x9386d743decb227b, 0xca2da887246f2248, 0x5fbd4da6c13e1f81,
+		0xaabcb949b8818f2a, 0xcebae6c5481a23d6, 0x78442acb539b80d7, 0xfb842258bd240641,
+		0xb9026890330cd8a4, 0xae03638ccb72c341, 0x60f7e36ce157d49f, 0x835920e7f482d519,
+		0x6d7fbdf913fd38f9, 0x855c0fbdd4d9f9e2, 0xe4c1abd51833ff3a, 0xe478741f3c7ca61e,
+		0xe1b9b8aafddc4e08, 0x4089a0927e44953e, 0x0b55942ed9dcdd88, 0x44993691f62f678e,
+		0x80903d3924db2e68, 0xb36abc7aa3bd3e73, 0x22ad203171dfe129, 0xfbbad56b74764a61,
+		0xc2cac0247ce751a2, 0x2601c69da3871669, 0x57c70b34d723fef7, 0xb88e89e6a51857de,
+		0x80f76297aaf95d42, 0x85e48a9e357fa746, 0xf7bb0024c7bbd660, 0xf30b145fa9aac220,
+		0x21bf2613b97b950a, 0x1f9d7a67d1f913c1, 0xb54417b137734c95, 0xa051bfba457da3b7,
+		0xf2ece87bb420e0dc, 0x85c75d0074b5ebf8, 0x2a093a16c3c4c00c, 0xd42b0fb251b21ba4,
+		0x881cd4c582622aa6, 0x227636c9e57ac9d7, 0xe9022c8d79f15670, 0x483178e3fa5a4ff7,
+		0x7918e851572c85c8, 0x8fbfe1c20fedff60, 0x8258a741a77ca98f, 0x68891c723b90a830,
+		0x8fc1a39f5c83b363, 0xbc7ce5beb67f69af, 0x63203936bdc34473, 0x29ed9f227cc72177,
+		0x158e23cc84d1c64c, 0x3336303c1b063117, 0x8e0ea566eb3b6fae, 0x86e9fbbacab8cf70,
+		0x11f8593c4aacc621, 0x8f2f8d3b74af59a3, 0x8a983096d23aa790, 0x6445bb9f137eebfb,
+		0x203b45e316cd9cbf, 0xbe835e7a06f80cfb, 0x1055b297596ebbd2, 0xcba5b4353804dfcb,
+		0xb70f0d8917839668, 0x51c792c899e49506, 0x346f55b76da3ae76, 0x5961f93025059960,
+		0xb0390ff24574faf3, 0x77656155fe012314, 0x194f0bacfb097f32, 0x9d2290670daf0f7a,
+		0x305fc20880ba4bc7, 0x4271a1b2d81ee888, 0x99a0a1430485ae8e, 0x5fe4af806aad03e4,
+		0x2d2c0bd7185a6194, 0x8fd12aab7d359579, 0x28e47c2a385dfa0a, 0xf599b41b62c5c135,
+		0x428f8c3fc17c0573, 0xa72fcfcde06ab753, 0x3b8fae4c4f5c8306, 0xa29a9a3cc6bf2726,
+		0xe5e3117f560e1cfe, 0x2644e606250d8cc1, 0x4521576291b1692a, 0xa30145eea820145c,
+		0x13095bbdc78b19d9, 0xe57168b13b145489, 0x83425127f2812abf, 0x314df2e7f941ab2f,
+		0x3fd797e78e977fb9, 0x4b7a9366fa76082b, 0x6089b5e428827a6e, 0x7f296a00675a0dc5,
+		0x46422e0758856b1b, 0x5d5d95fad76e9ace, 0x434022d2fe82929a, 0xa82a1acc72496566,
+		0x1e135d08d722a423, 0x90b5130a0e508221, 0xeafccc4bc1d36fd7, 0xdbf04dd557bf88a1,
+		0xec274fd5d08d7c9f, 0x48f2f511c055f1ee, 0xc12f5dd5766bf818, 0x9940059bcd0b3cde,
+		0xecb28ffaad37a07c, 0x2fda504b6fbb146a, 0x4b28f55a51ab4737, 0x9d4827538a7d38ca,
+		0xf3e310859ee2234d, 0xdd580b91e4551b7f, 0x723bd21b452b68d6, 0x7b26c0c37a2d9c9e,
+		0x2683163419b50bbe, 0x6269edbe762cbdc6, 0x8d8a619d25b6f408, 0x0cda1b8fbbd4d2cf,
+		0x8f1f19bc098bb962, 0x9353bcad52def90f, 0x39ab1d152818314e, 0x95fd1eeff394c772,
+		0xbda1d94ca3e2ccd8, 0x1d7e4004f7739187, 0x3d79839da4ab592e, 0xebef099ee1c0b678,
+		0x601b6cfe58f04bb4, 0x5d38f944a5cad9d8, 0x4891dd4b3bc6303c, 0x366449c13f328032,
+		0xd5e4afe83ce5877f, 0x50c2f42615a02fc6, 0x568cf2586ec720e9, 0xc1e6217fc2798c76,
+		0x8950debe1ed2986a, 0x93281253061d08e1, 0x99360932d4ae5ad9, 0x9843894f3245b728,
+		0x41f0dc2f8af36a05, 0x7666ede4cb64435d, 0x0a6aa408f4d1388d, 0x73b1964ab8d7d6d2,
+		0xe0921cb5e0103c9b, 0x47e0e8709767acb5, 0xa9651b9df5b87f1f, 0x03c421de2c048e86,
+		0xa9f5ca66b2e9aefe, 0xa6bc9840d9c34e05, 0xc16ca38cbc1fcde7, 0x21d5a785b576f24c,
+		0x2ed046ac37a1ab05, 0x1b54ba79bd795d8c, 0x4bba20a19e05af77, 0x2dd25457bf3f6a01,
+		0xed279c55c01c4064, 0x7bda4b945ca399a4, 0xdebc044042d7a50e, 0xc8d30011c9623d9b,
+		0x015fdfa58d382897, 0xe67dd502b88ef2e6, 0x3a3bc5fc7edc6825, 0x952df17e40ca94bd,
+		0x4348e4f34774daf0, 0x66efd7e0e569ae3b, 0x1277c12741e0a4a2, 0x46fad95d39643a92,
+		0x33283c47c5260eca, 0x14bbe93ed8b2c0ed, 0xf66e520fd9925a70, 0x57dabb96886fdcd9,
+		0x73d2a52ad892587a, 0xf3990557a81fd315, 0x5c82caeeef54c7d7, 0x408b2df2bd935b0e,
+		0xb7d76efaa15e3e62, 0x99895c6975d04aa4, 0xeb141967403e0f1f, 0x9b4f911ceaf21bc2,
+		0xc405d89af428ad84, 0x78b41a41f19f9133, 0xb698236aff1af162, 0x1c0da845cf737346,
+		0x7b9d3ff29e30435e, 0x440c90748c03ddcc, 0x255e6c526e6f3f94, 0x3690fb68b8cd98bb,
+		0xab0e78b94beadf26, 0x875fd8b8449b6fbf, 0xb37d53cbc6ab4f81, 0x9d1a2c54d4a1f57d,
+		0x03d04b9a71a6acb0, 0x4e99d6787f0fc684, 0x340f0caf242e72ab, 0xd7b91dffebb46011,
+		0x1d1ef03f7bf4bad2, 0x8215fc5855161c7e, 0xd4aaeb3d1e05a7cb, 0xd7df275845342df4,
+		0xb3a6e8886a08a677, 0x1f6790cc5a03e81a, 0x8d38ba2f4a0ebc69, 0xf62b6ea592aba7b8,
+		0xd310de6c38ce9f26, 0x68cfe7bbe1cd8061, 0xa82598927ef62592, 0xbb4c198dcd492e79,
+		0xa82411e9b4dfbbb6, 0x064c650399416557, 0x47ae2e8553747053, 0x2616caec32f227d0,
+		0x7e028b544dc08d6f, 0xdc67e8e8584b1229, 0x6f21c8aba9dfb653, 0x10efa01e4a2c7957,
+		0x022469ced8ff0439, 0x5de0fed0d88af50e, 0x52f87fe816e0009b, 0x0076a5ae0f508ca8,
+		0xd1a8e5c9b27250e7, 0xff7aaeebe16b1446, 0xe2bb3995c0810ca4, 0x46d71ca4550028b9,
+		0x7b794126a2706997, 0x021869f0db945281, 0x8ec45dbc8ce1da62, 0x6d8255f83cd1d356,
+		0xdcc56d92c423cbad, 0x5a1330f13549a168, 0xecf5809afca4b327, 0x5e1715a1014aaa80,
+		0x4d0a5e8e1b1cd71a, 0x38373aa70369263c, 0xcdb5d8c4afb71960, 0x8bf006212da439e2,
+		0x27e5e7464e6437f7, 0x20842454a86a448e, 0x1adf68f2102a0eb8, 0xfbaeb1c2391971fd,
+		0x9f68087c10e7f18e, 0xb1614279ab85fe44, 0x9cd1e2aadacb0b55, 0xb97bfd4f399208a8,
+		0xea785a715b2e910f, 0xf9aeb619aeb57675, 0xc210b344884fad98, 0x920de81abcc9734e,
+		0xd4abb8393a5814a6, 0xa61dfa41edbf316e, 0x205075b8afa0cd53, 0x66f28056d1527376,
+		0x36be314f852a0df6, 0x91d0471045f1f09e, 0x6b569e7287e6ffcd, 0xe92bbb9f11a89ff5,
+		0x2b7c5c4f3a3cfad0, 0x4e5a4771af3d5151, 0x720958e7e22baa5c, 0xff8b3a7f7badd882,
+		0x5f577874e58f9900, 0x39e2e60484c2c812, 0xd7dc862d2d983d31, 0x46a615620739fc8d,
+		0xebe06d249c14f687, 0x1d22783889cb6c22, 0xadeb0a60688eb43c, 0xca29422e7c62d093,
+		0x86db45f4d2985cec, 0x145f97755fd51961, 0x820fe413538dd439, 0xf09d54f2e1db0e0b,
+		0x355bef81ae60b45e, 0x8b3b3239883e1ccc, 0x166a0c46b8982a69, 0x191ba068e4df5de5,
+		0xfd9d9ca2cf431cb6, 0xa24f717abd2a340c, 0xf7b30ed19f5db30a, 0x74b44275b1bd5d80,
+		0xa9fa489de34db2f7, 0xe9993df065b9db32, 0x21066c2127d5f4b5, 0xdeb65ec7526f844a,
+		0xb735f9fc66df9405, 0x32a1552d76ed18af, 0x4dc889bda91a5ae0, 0x6e7adb1fd6425198,
+		0x0d41946146ea07b4, 0xee0345896551bdb0, 0x7bb6fae511b241ea, 0x8a10daee5e91ce13,
+		0x957b18b28c5242c8, 0xaf4cdbd8233ef950, 0x6a2ba16002470c33, 0xe5fefd58d93d14e4,
+		0xf3b6b5ba4570d726, 0xe227779720a9a2e9, 0x5c1dd354db4b54be, 0x1fcf40857e88cad4,
+		0x9b5750a18f3ad67d, 0xf93c1d2441281323, 0x5f9d05080eb5bfb7, 0x01aed14ce7cbf4d3,
+		0x63b5e3a83b922d29, 0x177b1e1600ba6e92, 0x50b97343c82b83e3, 0x475da0a8dc76b3fa,
+		0x0075021ee2e8f78a, 0xf1384d20c4e89b6e, 0xff13a6f2af9470dc, 0x85a95df7822eb510,
+		0x1e20fde616b4ee3e, 0x9e7d6385d986a899, 0x1f0acaba86539131, 0x1364fae840df9b8a,
+		0xdda08c6a7be19cc6, 0x02c9f1cbed748622, 0xc5a507dcbe32ced4, 0x736ce8a95e590699,
+		0x2761901166ab8887, 0xa22ae7bff5a41b27, 0x2225ab7a3ebc1c07, 0x8cdc8e48db99e3e8,
+		0xce47f5448c20790b, 0xf3e9e567566693a6, 0xd1c854b46f3a65ba, 0xec0432c7862378b0,
+		0xd9ed50d8e214f5d4, 0x6dfd345d40bec953, 0xcc2096f52d0896d1, 0xa5635f82f4e43b3c,
+		0xf4e5f8097b43a8b7, 0xd35206a09ce4a98b, 0xba7f9f7a713f9095, 0x7975d000a69a55b5,
+		0xf86fc1f60b0d0993, 0x3b11747d0cb35181, 0x021f57db84986781, 0x781fd4dacddc848d,
+		0x3b1ee9059bac8937, 0xac5aeb03641e48ec, 0x5bab6346fa53d26f, 0xd6540ed5dd87db83,
+		0xe240e1f487ba4d56, 0x1615f1ba4e874a33, 0x0f793a272b11c956, 0x8fd91c2f312081f2,
+		0x5b5f5cd96da1cb89, 0x0b497742f1996b7e, 0x4b2c65c2b6ee8405, 0xfe75482a359a9952,
+		0x3ce36ff16ba79dfb, 0xbb08032d08228282, 0x12872bafbbffeef6, 0x11c79b94bedf164f,
+		0xb8a6043f0ff78a3b, 0xd84f1799e5aeab3f, 0xbc3a770b1e1347d2, 0x838293626f54166e,
+		0x087ff2b432c424fd, 0x9d521ff1fb06ec80, 0xe40098b7b3f0f0f5, 0xb9891e359c38ee04,
+		0x6a1e077f064be46c, 0xd472d4d72ab173fa, 0x3b9da1b186f2b044, 0x3efea37296c3bd7a,
+		0x35a8bfbc1790b583, 0x2035f4ef6006dd82, 0x8249ac2beeb42423, 0x17bb4a1f597b53d4,
+		0x8a3eebc7db68d91f, 0x44937637c212b8df, 0x4f99a98a87f53aa2, 0x29e768e70e90d1a6,
+		0x1a793584a220a6c0, 0xff9ef310f75a90e6, 0xb55ef8e6ca08729d, 0xedaf2b59f92acdde,
+		0xec85a6a846b1d127, 0x4026b576c72c5e5b, 0x7978667507901a8a, 0x8743e50ab9471483,
+		0x342808f0a0fcea70, 0x8168c3993046d0ef, 0xcefb14f3ca4eca99, 0x5cc32d1b1d2b7e78,
+		0xf6a453f56ef95fcd, 0x8f028913f962550e, 0x266973fa2d1d8e86, 0x513faab9d57b351c,
+		0x21cc36179a87fb09, 0x4fae1594b9a51957, 0x8f93516a57e34501, 0x2d2bfe7b4cad6307,
+		0x94aa37c5d65cdd3b, 0x5cdbe6edf545f13f, 0x865cfef45eebe2fa, 0x22e4c839aef9f3d3,
+		0xbf432a248b61201e, 0x0914a67f10a06ee2, 0x5ec8b798c12237a4, 0x37eb257e3c67da9b,
+		0xc63768450d7174a7, 0x81349da41edd80c2, 0x6c37a0a42749ccf3, 0x97e81212d963dbb5,
+		0x439bd079aecb191c, 0xdd84e717449428e7, 0xc06a6871f0558f59, 0x93d180f8780848c0,
+		0xe6711cb72dbedf2c, 0x1280bcb9dbca4073, 0x1b67f3ee906e6238, 0xa3c7b6596d7c8003,
+		0xc80a3e6cbb7edb24, 0x175d78483b47c3f7, 0xcdcd932ff9c2e205, 0x8eceb2121cd1f5be,
+		0xfe36f8e60bbe365a, 0x012ffb18c4c24ff6, 0xb259a8eb94b4e0da, 0xb6e90d36f4af06c5,
+		0xb117a80ebf92ca84, 0xc4f74aec098e16e6, 0xa03167c4218ce91b, 0x27d3644f0d7f3858,
+		0x6bbe0ea73150fa68, 0x6b3c6f62dac7fa9a, 0xf643dd26b0010802, 0xea94aac4d12e2d6f,
+		0x135871a0ef409f47, 0x622143d2e427f156, 0x22ce18a4d41a6544, 0x484a53d0a71b83cf,
+		0x98c8f7122da7e563, 0x642043a21755569b, 0xd40f8ff1bbc72bcc, 0x8ae5ac740b9dcb9e,
+		0x23d2ce4100249133, 0x6177d64b75a5aae4, 0x0c8efa4bb81b6d90, 0x02784f4e49c021c0,
+		0x585a6af53bfc9c6f, 0x7cc302bc5eb351bb, 0xd8bebd6ad007091c, 0x48018a345c5cd82e,
+		0x3db7971f866d1926, 0x6c3f52e84ef5459e, 0xe4b5d88b6b348cef, 0xcea963c9178d2849,
+		0xb876534b87cf4b5e, 0x70e223141713359c, 0x6c8e60c3228a24a6, 0x06e0339dd8dcaaf9,
+		0x81ab1c0f945f840f, 0x6b0d677b93964713, 0xacc2cce99550d1a3, 0x6d8322314f3c3f41,
+		0x0f16654eb8ec9bcc, 0x73a2839c83e9485b, 0xa4c5aae094c2f520, 0xbda08345dddfe97c,
+		0xed39ddf0a0e76c7d, 0x7ccc9cded076d69d, 0x1f616a9ac0eea4b8, 0xfa13a4be8b8943d4,
+		0x352b4579abe35c22, 0x43474f4ba6c6695f, 0xbbce0792c3e65591, 0x3085bbd4c31ee042,
+		0xe52be3d9e6ce77dd, 0x88db38a733d27cbf, 0xe8ad7715251e1f6b, 0x59a85c0115eea829,
+		0x4a656932d66206a4, 0x2165d782e8ea5723, 0x5ed0ef80fa74480c, 0x8179b8124bbd0759,
+		0x44ae2f044a43cdf1, 0xe77cdb9da9d76a63, 0x9bfaa670489eb288, 0x963caf5d29ea2720,
+		0x950b5eed0e269b1b, 0xa41aa16a09fea28b, 0xdb295c980c4aa165, 0x483997afbf723aa1,
+		0xa4a6f92ee0f66a19, 0xcd145561e051b8da, 0x21e5e855dd7870e4, 0x55f7a654050cc97e,
+		0xa81eba255794c9bc, 0x08ec29cbbd5ae372, 0x90a8a796b4a5fa3a, 0xd50edc20c01e75fb,
+		0x6dcb868bc0848b40, 0xa543a818937155a4, 0x3878e9f927de006d, 0xcd25df240d2a297d,
+		0x56949b8d17999713, 0x61206e5ad21bdfcd, 0x23d23c0061f6ce92, 0xf5f677a6786cd04e,
+		0x21b478d40eb0a105, 0xc5b907c6c7ada790, 0x322494a9defcc879, 0x02b07a679d29c18b,
+		0x09531c06840c316b, 0x58b9d9fd45a72267, 0xe198e13551e23e34, 0xe1ba07eae5ece7a1,
+		0x4d0988c2bba83a99, 0xb10cb02eca38c0c1, 0xed2bc0b8f9d5de4d, 0xc682c387b475472e,
+		0x76d6fc3dd6a67fc7, 0x8694e03bd06a2781, 0x3e8499343fcf3542, 0x4eae5b217f4f812e,
+		0x94f692c3e382d59d, 0x41cd24724e202f61, 0xe5cb4de7c06d6041, 0x080cdf943dda32d5,
+		0x76406b44b0a652c9, 0xbe7a20497f410c8d, 0x59c0bac7eff984ae, 0xed155af94c50fa24,
+		0x78c9220304c9c990, 0x87fec21e01af8b2f, 0x0d2ad868a9806bbb, 0xf1e2bbbcb18916b0,
+		0x4a8e470f7f945e27, 0x45da1ca3d5a3e8a7, 0x74a03548c6921e01, 0x3b9d330e56c4db40,
+		0xbd8f398800299cee, 0xb507eede33049783, 0x67f13c4428eccc43, 0x4eab08ba42d0d46e,
+		0xdcadad88b336046c, 0x053b17e9164940a6, 0xc4268e42c1bbe4a4, 0xfbd7957b8e5d154d,
+		0x91ae2f0920142217, 0x60058fe55fa87b5f, 0x1fc778ec236e2b55, 0x56d027a3867309fb,
+		0x20fca77f2d032db7, 0x83dfec943b6ff6aa, 0x09e21957eb61ff45, 0x1c0bd423f03aed7d,
+		0xffc401b238ada86b, 0x51734b18a97db92f, 0xaab4591be706e1c1, 0xc5e2119c3c6614b4,
+		0xb6f4757e5d79467f, 0xcdbb5729cf41ccc1, 0xb543a214b0d48146, 0x75586d60eb6720cd,
+		0x75eb46c2b421abf5, 0x0e9651418c728413, 0x653cab7142684977, 0xbebb7087a512635c,
+		0x5bd49a018b7843d2, 0xe8e51884be44b45f, 0x0f3232fa671894c5, 0x8d5ee01bec33d60e,
+		0x368e7f2e6975f305, 0xb04f205a43b7375e, 0x3e70e52486f787cb, 0x7150bdbfdb704347,
+		0x43a8755ff4d17374, 0x45a2f1604aa0f7f1, 0x5b22bb3ecc286bea, 0xb380965fdb6286ff,
+		0xad0c1c5bdcdf4c49, 0x330f3d6d3284e1aa, 0xb947a94ba0917974, 0x953c9db5625145cb,
+		0xa5e62fce5deb7524, 0xe883089c1b3bb286, 0xf0bf83df257de1cb, 0xf5a18fd191892273,
+		0x5282b24a413007bc, 0xdcb388024a904867, 0xba867bce4046ad82, 0x02e89d3ba164da20,
+		0xaa9ccb77b3f711c9, 0x054c4494611b6160, 0xd464e2e9fc74780d, 0x3cd8e6f404d8df6f,
+		0x21a69b472d4f4677, 0xc63654fafa4cdf11, 0xf0e18d6dcaf564ce, 0x530ca51917e7febe,
+		0xb682b6003b1d17a1, 0xf7f749c9cf47a9c6, 0x5fd1480ece847b11, 0xb8a995af5c779a84,
+		0x70c3b9821c8968c2, 0x2176b5c880b5cfc8, 0x935f46a98ee0a129, 0xfb857f651762c0d4,
+		0xc59435551e3fce6c, 0x7d7161162b86a02c, 0x50ea9d5d619db4a1, 0x57d62dcae5d9d2ae,
+		0xd85da4e1de2e902d, 0x7bd4602bb7875f13, 0x0ebc8f178378db68, 0xaeb900a0c58058ac,
+		0x34e815872830a7a3, 0x32e35bdf8f52f2f8, 0x35c0e1d6bd5efa61, 0xf69467dc0fec83c3,
+		0x34396268b6739a9e, 0x97c3c8b64fce0227, 0xa31c6bd9267f7a65, 0xa23c8ed53aabe8cf,
+		0x5224fe38a14d29e2, 0x09b7af9b7240a2f9, 0x864963b12f4d5657, 0xd97ad2b244b01663,
+		0x4d355e61235f9b0b, 0x54755c22ca780cf0, 0x2857a7a602c9e372, 0x67393290cb9a586f,
+		0xfca8a374b89c3d0e, 0x513de37e90214fad, 0x2d8a3e54e0213595, 0x4a6b1dfdb91d9f4b,
+		0x020fd2fcc12e2bc3, 0x6f99693bd02566f6, 0x5730068f60a81a69, 0x32f623502f101b36,
+		0x87ed12d83fba32f5, 0x4ae0a0bf1a77ce8c, 0xaf641907fff06f7e, 0x4a4855ac7cdb8a1c,
+		0xdef3fe886b163516, 0x95bdddcb6c1604f8, 0x44e002e92e92c566, 0x2537c6746c86b0f0,
+		0x021f5c421e22ac9a, 0xcbdd625079a9dcc4, 0x3bca715bb8784f62, 0xa7e2afb14337174f,
+		0x9972c37eddf0eba2, 0x21503817f78c7f63, 0x2827001bad20bcdf, 0x13e81c2b2a11fdc5,
+		0x2f4a8dd91dcf2e28, 0x5f39f6b664fe4925, 0x0aa7d186fb608b9d, 0x43ad07d4d0b13420,
+		0x5059040ca328a108, 0x308921c62dd53561, 0x9fe15e0c14c5dcbc, 0x2235033a837e60ca,
+		0x158c8932efea0c51, 0xac1825e2ccaa3a60, 0xeea6ac2498c1c194, 0x90e59d6ccdfbdd30,
+		0x593ba0da3fe02351, 0x6abd6f3df04490bd, 0x99d5a3c75b774f61, 0xd6123b476bbd5e1c,
+		0xf7118f6e132fb7f5, 0xc72f86e4ca43dec1, 0x9bae6517b47fda9f, 0xefaced65d3aae5f9,
+		0xbdaf5f1220f5a134, 0xffef12aff69d0c04, 0xff86cbe4d94daea3, 0x01e8c3df42150e53,
+		0x5d5156a7a01dfa3b, 0x8b83c9b951b491e7, 0xe7254ed5a9a3a3fb, 0x681ece4a6d74840f,
+		0xb7fc88520679f21b, 0xf819db0a6bf89c5b, 0x3536e1f4dbf19c7d, 0x3f83d3c4b6a6116c,
+		0x4056088861be3fc0, 0x7cb8eaaf9841138e, 0x4099c3f8bec9212c, 0x58b42f097c4829c0,
+		0xf9fd5eae710679f1, 0xb0f23046144548ed, 0xb79f01c7aae89633, 0x2db5c5e1c29b5e74,
+		0xe3d55dba2f12abd6, 0xa91578285a0a795a, 0x3dc059fce0deeded, 0x76ea3aa406cbec24,
+		0xc7cf9661d37e3312, 0x4a20b75a4fee8efa, 0xa3c74d25478d5d63, 0x79b3b931cfd6dbbb,
+		0xd95e08da1619f7da, 0xb72715b022b0c4d3, 0xa133e862f8285b20, 0xba988f52853d272e,
+		0x63d85179e27723da, 0x089dc6d9cc90e8cf, 0xc48dfceca480e185, 0x4af9363e9f70da28,
+		0x4a6c31c37fb2f673, 0x9fb37f6ffef398fc, 0xb11ce244a2399f4a, 0x392aa2dc0466ce3d,
+		0xf1dd52b85d82d1f6, 0xe34fa53857219b0e, 0x1ca8e188a8be69ba, 0xc93e3fa8778937a0,
+		0xceaa7570ea7dcfaf, 0xcea1345e14369310, 0x20af2836e93c9ab6, 0xc3d02d22bd023499,
+		0x57318cdffb8e9893, 0xb791db577144d1db, 0xa36081b6976eac6c, 0x91d8831191b98587,
+		0x1e0133937a6eb2b2, 0x21ddc63d609bad4e, 0x91675ebb4555276a, 0x009056d42f9df18b,
+		0xc5209c8f50b3dd7d, 0x8e469f1474f7411d, 0xe257e8843802da7a, 0xed33a76ab55617a1,
+		0x80bf6301ca2dc12b, 0x90f428298e1a8183, 0x7f3f554cd1f420d7, 0xc2af1a5b1ad1f9b5,
+		0x67465c11a6c33b6a, 0x2382ec644283b2af, 0x35ace3b67b27493f, 0x6fdc7e6ea3ab6808,
+		0x155cc3bc91d81651, 0x819409c267bb15e6, 0x87348dd33dea59be, 0xd6609d1e4d325b37,
+		0x39db8cdbec45ba06, 0x3f46eca87f183987, 0xf16e4f158fb1e507, 0x7ffb966e5167ef3c,
+		0xc4f86abf1c93f844, 0x042bf601a2183e23, 0xcfc6c5e10844b585, 0x07ce584d481fa13d,
+		0x1f2c47d114065b6f, 0xdec0c0712b86352d, 0x888ec7cdd9f2c949, 0x765b2d370c36f977,
+		0x4ad004eaf580a679, 0x1e76dc88967585b4, 0x91736ba33637523e, 0x07acf1f63eff82ce,
+		0x03b32c510a430e22, 0x5aca6be21bd0768a, 0xdc4facc67ca70658, 0x17dcd706f0f783fe,
+		0x11f61ec2d95ede54, 0x5897f155c5db3514, 0x0e6391339da413af, 0x1a9521dcdce2dce4,
+		0xf94c936740b4be4b, 0x3741fb0caaa322b2, 0xe4ec046c15edb6da, 0x95b8a81846f7c542,
+		0x33370bbf5982e8a6, 0x3123b7a0666b2a49, 0x7f7d5c536e1f0c83, 0x30861e37de9035df,
+		0x1f7d7eea3f96670a, 0x109f95ba9e51a5d5, 0x4e7d8489605cfcae, 0x3f1e6002ca7450c1,
+		0x385f8d59e44170e5, 0xfb0e05e0249c3e1b, 0x54a6b03dd0f737d1, 0xf84d65405a55af47,
+		0x3ec7e2efd4424420, 0xc612c25bc01e6d12, 0x6c2dc7e3ac8baea4, 0xfd1b45b1652d20c2,
+		0xed2300fda6e126ea, 0x96bfad6842de8568, 0xc2bdd2d551df30b0, 0x96bb7f7f2e8b3841,
+		0xd9995ceac48dd237, 0x86df7fbbc78cfd38, 0x83b5f636a4fc7315, 0xe808246a052ab689,
+		0x50311a0215746010, 0x13ea19ee63a7dabf, 0x583c64599b6d1889, 0x1162e994ba76e692,
+		0x708e9a8b354d59e6, 0xe5c7939de2ba2a55, 0x88f0ca12e9cdf333, 0x70103e8775885792,
+		0xc02a221c8ebcea79, 0x732ce8e9343e1d35, 0x6d58d63e6b432f26, 0xd604e599dbb2cb24,
+		0x776a8178e744b608, 0xb004bc04014fb188, 0x61d8052b60f912d3, 0x59687f210fd17e27,
+		0x06bd7a5ec0504f17, 0xdff5635344bce5ef, 0x81b644ce2320d2ff, 0xa48031ef65b2b7ba,
+		0x0cd16fa60cc79b8a, 0x805d22efc4106324, 0x0ee1e471f2f2376f, 0x5333ab51cfd73eb9,
+		0x17b3b3405736a850, 0x2bfd9cd917c058d0, 0xfef81449d695bd3e, 0x41918f174784585b,
+	},
+	{
+		0xa013a816aafaced0, 0x82307de32102a73c, 0x78df89c72e5028cd, 0x1fdaea2e7ea4f557,
+		0xf2c5dd0f0c984792, 0x354aa9f22bfadc6b, 0xeb95265aea762341, 0x3baf31280a49b8a2,
+		0x6d81beeb1ffe4236, 0x0ed468b6e2b6198e, 0x25509e0ff158b13d, 0xa7e2666cd9978477,
+		0xe4a3b682ca443eb3, 0x4224555d2b516e83, 0x899633c30e3024c5, 0x21aa45a8be380e8a,
+		0xbf8d0359ca752ec4, 0xfdc998640ff99706, 0x6125b94b725a32e8, 0xfbef7a117e0493e2,
+		0xf3044c9ebdaa4e84, 0x2096e33d0135a1b9, 0x582e077aa58b68a3, 0x6a514d7de1c7f759,
+		0x04441fc6f7c2855b, 0x11ddb4de64162d85, 0x663bb8f21463df85, 0x6c72a4e91ebf682f,
+		0xb86cca50667bcf46, 0xf45f713b9352dc03, 0x8625e48f2d648ab2, 0xf72cf728281e23b5,
+		0xf60e16b7c8806ede, 0x895762b9c93331a6, 0x014750eaca0eb683, 0x7e01098a3f35b2c7,
+		0x5ea8d2cfa8271a73, 0x0782a4310aa1fde6, 0x0214028914222930, 0xd712694088cea1a9,
+		0x567fd59d5ea4774c, 0xade110b3ac2f100b, 0x404d51ead5f3cd47, 0x4416e4857c7ebe55,
+		0x4253328cca5a6232, 0x9ec6e1e75b979803, 0x43d19d2814c4c6a6, 0x563b224993e87746,
+		0x2bc8f5c9e57685e2, 0x3a3da176ed4d5e1a, 0x7f48a3329b62fa1c, 0xee269b1ae0d6bb15,
+		0xcee5d43f88c6f2bd, 0x0681d5962fc30052, 0x22e3f46775b46d6c, 0x913ef079616094e4,
+		0x831ddab2deaafc4e, 0xab667513d707d748, 0x27c9afc61578d40a, 0xe240551c869f7802,
+		0x61e3210190e59486, 0xd330c67c84d8dc68, 0xbb55f379cb50678f, 0x382bf0d0a7b7ce7e,
+		0x4367bf2cc0e3d774, 0xd94a0f23be0a9f2b, 0x1e8d8d871d1373a1, 0x5cea749d051069c3,
+		0x29af5ba206f1ec3a, 0x53981848aa952c1b, 0x7fdb37b14ce41830, 0x08bfe3f38aff42d2,
+		0xa02966e32495d60d, 0xbcd7fc79d17cf871, 0x5208a3582cb84be3, 0xbd5500e03b97a0d9,
+		0x6b79347554da165a, 0xd95e18f4f1a8100a, 0xc134d40c083446e4, 0x51c4a6b312b338a8,
+		0x43e94ff5294893be, 0x07972998d41a6fc5, 0x2fbff40d66ee3d51, 0xaed9a006a99a0e00,
+		0xf2a1fcdede9cd3f3, 0x06d3dd49a406d89e, 0x26b780ab210ec2c7, 0x9721dfde2ef580b0,
+		0xd4ba630a711bce88, 0xf7161feaf1f8494f, 0x32810bb13c6bf6a9, 0xa00f46a314444d8c,
+		0x88a5c55c8c7a657d, 0xc33579372f8cc25f, 0x5110dda0a4e0413a, 0x78ea7665cf617b52,
+		0x25e8d766112dca6c, 0xebb7fe00d40f5f66, 0xc001809f4d75391b, 0x05750098fee342b2,
+		0xf0474a2bbf6bb1a2, 0xfdd0ffb8b1946a3a, 0x72124534eab04716, 0x52f4dcdb154778c0,
+		0x8f0ac7a15d0dc32c, 0x4913455b7db6717e, 0x41d920abbc4476e0, 0x86595edc98ad6d68,
+		0xf510c88b3e037e42, 0x9fd555b802707678, 0x15bcd06a23b2c6f3, 0xf96143fe4246eaf6,
+		0xd700565a8f76a051, 0xc1793b2bef4d58b4, 0x58a2b90c4ece98eb, 0xbcfb055d55211bb5,
+		0xdb05657399f47c81, 0x5b554e539aa6114a, 0x0a0a6f54c3b66e1f, 0x73d2fb9094d7f166,
+		0xe0ebe04f5306531d, 0xba2325e5d0efd5c7, 0x663bdab9330c1158, 0xe04e182477ed2066,
+		0x017419b346366a39, 0x5299b8b0dabf2aca, 0x818dc07796611cf8, 0xb16fe72139ad3d3b,
+		0xf1091dd77cb1d8fb, 0x56ab33ab98768aea, 0x504b5234101f60b9, 0x6071c40e0e376676,
+		0xf1fd664eaf811467, 0xd5edd7725e2f361d, 0x6aefd176d4a5cb15, 0x6ae8c6be4864457e,
+		0x2b646ed9da771af4, 0x2176398c43665b41, 0x0f0c713e075bbb3d, 0xc3c53fb22fa35d1d,
+		0x3241842bd30cc904, 0xad729cf4d959d53d, 0x5bc6c959e384c2c2, 0x877bb897c8cc2f05,
+		0xc3cae88b557c98c9, 0xb5c8561138874f63, 0x29a34d5a52bfbd5f, 0x4dd5273770f75e80,
+		0x3a55dd29bc065fde, 0xe5be8abc28bd56cc, 0xdee31fa45add05b9, 0x2952780f71326be6,
+		0x49f0b1ce8b1b826f, 0x1fa777721a04941d, 0x14f9a6f973f7af83, 0x308c9bd0db973055,
+		0x49601a8ed6e2ac7c, 0xe31a14df888962b1, 0x672f3a927f957c3c, 0x85141fae23272296,
+		0xd348c0b4b458e936, 0xd525b26c0c8a2694, 0xb24ae585cdd23d2c, 0x84d8dabdd009451b,
+		0x74b8d36bbf2b3f7a, 0xad62f66fe946612f, 0xf7b44131a8701333, 0x5ce7d187af6b5372,
+		0xbbff97abd3c1b14c, 0x2db62711457adc19, 0x1cff250efab84ebc, 0x1367eb8c336a4686,
+		0x1af4eee833f21bad, 0x78d6bc99651ef934, 0x9e86b5bd8646d95f, 0xdc850ef1caab1792,
+		0xb844229dd2c400b0, 0xe0bd2dcb90988538, 0x96f8e27a11b87593, 0xee848a61791152ac,
+		0xdf387dc7b948908a, 0x40715974887e77f6, 0x875cdcd60deeebc8, 0x1eba1262fe2988b6,
+		0x5d7ca03ee0c3af23, 0xe33449408fe51dd5, 0x0b5c840683694da5, 0x9e12696358d21a88,
+		0x107ffe24f4a4a0df, 0x2a36e491821bbc98, 0xd5652ea140ca9203, 0xb9ca4f4bad687ff0,
+		0xc797316096f3c789, 0x88612fda82a697ea, 0xb5162feeb377c12e, 0x3bd21d91652de025,
+		0x31d4a8606b451c1f, 0xa1375334d76df58b, 0x96d0e2ddc152ecbe, 0x24e5bd9f0f86486c,
+		0xb45fd48ee33c09f1, 0xccf992a7565f0cab, 0x067bb7d1d286242b, 0x4b39a0260548450d,
+		0x4bf06ebada7efe0d, 0x99d09002ba8ebbaa, 0x9c129b34499c956b, 0x3da377d2a2ab5343,
+		0x3d6ba29d88fcb1d7, 0x809da725d51c2dee, 0xb8623b6936f35c7f, 0x5f19cbd66d768f4d,
+		0x10153b351cfc89e4, 0x2d08b665fcd179e3, 0x91bd623a294616f3, 0xb38200177da476ee,
+		0xf1b37945b843dae0, 0x9742fd6eb3b9d2ad, 0x452f9bacb2d69880, 0x9dd3cd1032095c4d,
+		0xb2226eb2d2e6687d, 0x80653c2c749de2a9, 0x7d607d9e57d3879e, 0xebb9f35331bcddad,
+		0x914317b226d96de7, 0x540a0f41dc416045, 0x315fba8f83c42b61, 0xfcc0441bf04b29d2,
+		0x450e462f27ef1669, 0x71e62a7b7a7b38c5, 0x417d9123c40bef44, 0x7564f38f5db74d2a,
+		0x27f90c18a0c255ea, 0xb4584d0a1af60e0a, 0xd70b733663840b3a, 0xf7a233eba784fe6d,
+		0xe1c81de7f51ac65f, 0x4a9069c0e3a8f974, 0x7ff806dca1a5ae48, 0x72f1a8a632b614fb,
+		0xd894da9d510eec4d, 0xff3a8da46bd9bc67, 0x9c5b32d7e2f108b0, 0x811505eae99d04b5,
+		0x710d2e4814ee27ae, 0xb8d71e7af8dac1e9, 0x2b93e2adb8201268, 0x52f2c85e9e85b7a6,
+		0x25c10985643e7796, 0x092717a1574080c4, 0x9750261749451586, 0x22d0ef99e7688fe5,
+		0x30a1f998538b745b, 0x382b22ccf7c5a3ec, 0x624c3ba6bdb78ed0, 0xcf6668f9b1b63025,
+		0x761e2f9ff9748fb1, 0xa118edd120959614, 0x9c35e5ed4f88ec19, 0x3204ff6d6d18d698,
+		0xa6b428c4271c0eac, 0xe1b5e3c54be85944, 0x59ef408e64e43cfa, 0x583723160aafdb8c,
+		0xba046fc172c40be1, 0x27cd045bed028234, 0x10ce58c96b076650, 0xe8a12f9d9984862e,
+		0x066d398e8c9afde2, 0x506343c0768a3c16, 0x799eec765595119c, 0xc120a2b9e4bdc1cf,
+		0x1d69dedc4af98f7e, 0x5b3a78b6cf6c6dc7, 0xff3d10c6c0022019, 0x92857f225e6be2dd,
+		0xd5d28a442c76a8cb, 0xff1c16e9301a7afa, 0x34c0d5463820be84, 0x992c6786cc2a6bee,
+		0xd88d5a8d48683b53, 0x89537b4c5b459465, 0xf2441e1d3b7f6c16, 0xecee55ee46f846c6,
+		0x23b9f017139975a5, 0xb98e3485058a4421, 0xce57e7d1f101e3fc, 0xdcfe4bab65fc334c,
+		0x01a89c47099dd84d, 0xb7780ff72cb82618, 0x6ac66209f7ebec05, 0xdbfb8edfab9de5b0,
+		0xc40ad6d82b305acc, 0x5a2c3704f124276a, 0x132d3c8b3e107e27, 0xc022128a5f525dbc,
+		0x4d020b50259c220e, 0xd66d17e6cffbc6e5, 0x16176228883eacc4, 0xdd962b2d7cb36efc,
+		0x7129a1a92f01565e, 0x013d9f726099a3a1, 0x926052826f302a4e, 0x482fb04ab1b07e77,
+		0x434ef4ee52988c03, 0xef20dc61166cd315, 0x75fba075ed68338b, 0xf4dc6edfca80d9cc,
+		0xb00b14b0f83c3404, 0x9b6d61499c1b8b3e, 0x0df2a3c9a64cf9de, 0x6728e281bd5f78ad,
+		0x2e7f8442bdc4d6d4, 0x7240b56af324aa8d, 0x8193a5812d92ea2a, 0xd46c1c22503e1a69,
+		0x6bcc3de92bd56c85, 0x969dd1583a1706be, 0x754ad1b014b0461b, 0xefc83feaa2e1f683,
+		0x108786a2a8d6c3a3, 0xf831aa6c0c71e2a3, 0x43e8d06c69d9b74c, 0xcaa823882502c4a9,
+		0x016403082950bcb2, 0x993db0391756fba7, 0x10bdaf10127d3577, 0x1ec22f386e49bc5d,
+		0x705389f875633f2a, 0x3b7786b805244a8f, 0xd366b8a5ff2631f2, 0x71de2a801a1cc4d4,
+		0x57855d35970a08c3, 0xefa3d2a8a3bca096, 0x35745f412fa5b2be, 0x614e77ec5cdb5605,
+		0xdfa64f2d8fcb7b47, 0x5914e72c4eba32db, 0x435579f3c45f67c9, 0xb93336aaa17abd8c,
+		0x3024d0bcc9bdb334, 0x80bf2c8f3773440b, 0xcbcea1b93bb6f947, 0xc92e7aa0f545457b,
+		0xb0b038d3bff26150, 0xb0d8b26a084fb8bc, 0xfbea7508ab2d79a1, 0x309d863ac6a8bcd3,
+		0x4b7770ef6a2cdb3d, 0xa7a6327e11047151, 0x88ebef7eae8626de, 0xdcd5e57af4007b36,
+		0xa6c47a54916d21f8, 0xe10ab9dd16eb265a, 0x738158776a4390a7, 0xcbd4a17ac743b33b,
+		0xe007e68ff5dd88b5, 0x1836b355884a5bfd, 0x2917fc1aa9778845, 0xd9781e521dcf028e,
+		0xd334f716c00558ea, 0x509cc6b9f659852f, 0x6d9169507294ac91, 0x59a51cbee548ce40,
+		0x7c26e70ab2484b58, 0x20d859d2b12524f3, 0x5a4b1b8ed0b2ba1c, 0xedd5a8478c686271,
+		0x942e2a1534599653, 0xa6e028e486f9fd30, 0x6c245683dff3170f, 0xe82610afb181b109,
+		0x1ad6d41efb67b179, 0x986a70e776d3a96f, 0xdc20f5e4fabcd879, 0xebd43039762ddb62,
+		0xd9af347afcb8291a, 0x00ceb3327fbc7db7, 0xf77d86189e030f6b, 0x15c944cb04dfc1ef,
+		0xf9f857f8b3b010c6, 0x7ec62d2b1f84412c, 0x7f920880ca1e158e, 0x3b6d567efee95975,
+		0x5f5b55cd6a778fde, 0xe37ffea1965e15d5, 0xaed040b00ea8ef1c, 0x1f4c904c883391ed,
+		0xda650e79bbedd215, 0x20199a3cf2f5aced, 0x62e309829cb8bdd8, 0x0184191633bd7c6b,
+		0xea47032c69f75bb9, 0xbc9f876ae50b3de9, 0xb49f77dacf38a565, 0x77c981ad2d7a2f84,
+		0x2cffa0c1bb70e5a7, 0xf580ae65804bb6cd, 0x4b57c8a8466f85e1, 0x32a1723c895848a5,
+		0xe4badc64b3e57e47, 0xe07a869cdea489c4, 0x900a7e4851f60dc0, 0x257b734f540e8155,
+		0x496d3cc4c0fde234, 0x19af0f4053705a84, 0x1cf091aebcff654d, 0x5c30744adf1a9d72,
+		0xfacfbe04c82b4e88, 0x283501fa60c4ccf1, 0xaf605a7b8328987e, 0xc3c6bb7add787dda,
+		0xc5909aad6eb89840, 0x0a980bd34102ab64, 0x469fac3877f4786d, 0x8f50ece99eef0a5d,
+		0xa3f333aeb93c509e, 0x2628388309d588eb, 0xd657665b9d43efb1, 0xdf4cbe0f58d4e5d3,
+		0x8d053ffbe21393f3, 0x8164b11b75bc14ef, 0x2b27f32de07d5bfa, 0x4a492bb3f70d78f6,
+		0x3db3549115ae152d, 0x9fb75bcfa8207483, 0x0bad8381f53b5cd0, 0x2828956b3c373782,
+		0x9da771b0f7b45cba, 0x6debd60a771930da, 0x244213e8bbd7226d, 0x71ce1b85088f7689,
+		0x6a69251d5bc748c8, 0xa63b82951abf8c0e, 0x25f2faacea190dde, 0xa576d912fca330e5,
+		0x11b7d7fccc53291b, 0x5d181b81a34ad7b4, 0xad6ee7ca0546fcc3, 0xde1a099fad7064cc,
+		0xbbc41642f2b014c1, 0xa45aa8b3bf601d13, 0xd684603d73f2279c, 0x7162054991d4ade1,
+		0x55ebf4f79152037b, 0xbf12371fbb4dec47, 0xbc440a9e287b600f, 0xa4cfed29499b75bd,
+		0xf33d6ed8dcce557b, 0x12e67297ee208790, 0xc7e77ee74e23ae05, 0xaa74aba1f9dd91d5,
+		0x6283d275507fa6c8, 0x96af7d367b9cbaa7, 0x4199d7f5cbd53504, 0x53cd2dbe9c1f5182,
+		0x5150f10a93b2ca4d, 0x610efdcee7d5c292, 0x3df012d265f71f7e, 0x4cee10a897b1d53c,
+		0x1ac37a3bfacb3ef8, 0x496f6d8103272594, 0xb61108e36d52c1d6, 0x7576d1c987aaf16d,
+		0x125cd9dd79983a8a, 0xb9dd201e715aca7f, 0x349288ea179e728e, 0xc1c3533a910e1131,
+		0x2e434d05c6737e4f, 0x11ac94848a00019e, 0x5dcf24dd9c4fe834, 0xbc2af431729c0a51,
+		0xa99bb95816748252, 0x4bffebbd31f469dd, 0x4bca2e100c002e41, 0x5180ee1b85e9757c,
+		0x67a9ec2c59d1f06d, 0xebaf450bab06751a, 0x46f6def94bc3cebe, 0xe8b9dbc070757803,
+		0xc50a3d63bd719f83, 0xc138bfe888e70f26, 0x6c0199a828f739de, 0x2295badacc162c4b,
+		0x6af20e168f1cce17, 0x45cd209a5b5ef3fe, 0xbf8e4dcddd8d1496, 0x9f4cc45cc0b31c06,
+		0x3ad4a486ba1076d4, 0x80715af4be2ddba2, 0xb3a420b49040c94b, 0xbb188bdb3c8a9b24,
+		0xb0878b99a4dd4790, 0x986def382c934f21, 0x70a681bfa1fc7333, 0x52adf015fc1e081a,
+		0x1c4fe883c8de0dda, 0x9cf494133b0f9b60, 0x5c8c514b8d6c7fbc, 0x54831f16b863c666,
+		0x7113892c47ccf735, 0x0f0efea3fe7493a2, 0xcf2bbd649415bb52, 0x8495e287497638ba,
+		0x8ccd6f19b593db23, 0xbfc21ae575d5bff6, 0x49ec36a4bf27e1d3, 0xa174daf8a071a256,
+		0xf930ef19b3588c2a, 0x15437e2a61ac9703, 0x8158e7729fa79947, 0x18ef804c3d729558,
+		0x3955cbd305ba7583, 0xcc4deac8ef1d3833, 0x6eed0b496571f1e3, 0x005b6f93261a5d6e,
+		0xa777161d5462e338, 0x16589c60f8a8f266, 0x17825dd536e13585, 0x9a861d656469edad,
+		0x7a4ccff4ea5108d6, 0x9e6a892cd842effe, 0x7811ddb81abf31af, 0x88fdcb76a2c2e984,
+		0x7279b61225be69ca, 0xd616012bb8ac5973, 0x6fa55a41f2fcc67a, 0xc56e21647a121fa2,
+		0xc587258c54eecb26, 0x0e6dd86be9e89428, 0xfc6e3a8026f7e4cb, 0xf80536d15158d757,
+		0x6067016e76c87905, 0x1602dbd09f8c9e93, 0x782d42aa6c9b8ed5, 0x7c8fec7022b7a52d,
+		0xefbd3e9a531a6cf1, 0x13a366d80a20d47c, 0x840b3d48df9a9b7a, 0xf468cb85c9aca232,
+		0x589d109c4594d6a7, 0xa61cf5a9a58f1161, 0x0ee2560b301dc499, 0x2e37c5fb328d8fca,
+		0xa5703e36b28d0dab, 0x8305b2252c387aa9, 0xc46824a5478e931c, 0x12262e4a1677f360,
+		0x4712d5fa1398af0f, 0xa07f8d59c45bd79c, 0x270f4d06dc06e6e9, 0x0a9c86c4bc9399f3,
+		0xd76f47949fe5ee1a, 0xeae368cee6a769de, 0x7d5eed0ce23fa2ba, 0xeef52fa2a8c079ed,
+		0xaebb3bb4859b456f, 0x4472062e26360d84, 0x26a24cf5bc3d8481, 0xd333696d35871938,
+		0x5cefc635c506f8f4, 0x1ee990b4b1998463, 0x4f02aad54d49acd2, 0x12725dc373e1257c,
+		0x7f9757995156a0d6, 0x761da49be4ede99f, 0x91b73f2e1d09efbf, 0x7ed23829e974aaf5,
+		0x4c1eb44b35568358, 0x212d09cfd9239d59, 0x2a53fb2966cf6a4e, 0xf8e0a51de507d435,
+		0xbaa472ed6914ad24, 0x416f1f6a226cfdd3, 0xabcf755dcdd88de6, 0x03d8f7a41a54fde2,
+		0xfaab537400e5857e, 0xb12b3d62bd304720, 0x7bc17855bdc4ffd2, 0x81c71b1a69d7ca17,
+		0x9fe315571b92a5c3, 0x29d71fe969f3a6e0, 0xb751da717f83265f, 0x28f8b35fbdaf1eca,
+		0x49865b71a1cc166e, 0x050a130d1de982c1, 0x93d92aa8b2bb56da, 0xd1da57cb0d85d813,
+		0x7d479e790a892d32, 0xb4df9c006bd74706, 0x0ba221e58eaa86f1, 0xd9ba6b1f8e89dfb2,
+		0xc886286b32ecef08, 0xbe7c3e547f987409, 0xa361bf03cbdd3d93, 0xbabc02285547e872,
+		0x17018388b91f9650, 0x4d4d79a733f4e0be, 0x19f305b259a20fa4, 0xd2d7c8b2aef82db6,
+		0x920a13748fa8ecdb, 0xc2b1aada3b790e90, 0xfd4c99b1e06843a0, 0x35f3f994aa5abb25,
+		0x5c9e35877a986883, 0xf7b2fbff16f76cfe, 0x0b0142e98604797c, 0xb5669a21e9763481,
+		0x0d018c6fe2d42621, 0x6d9b1017609a5bc9, 0xba4ee20cf0aa3a01, 0x68d1e48daf394713,
+		0x4229108743b59b4b, 0xb8f1d9c59ab172d4, 0x737d633241b267fd, 0x4815f973d3bab883,
+		0x830089a3a91b91f0, 0xed40ff0d8ce262eb, 0x091a1f81687db2c3, 0x5536e1b69ccc5824,
+		0x5f010fdfe90dafc0, 0xcf93419fb8d4c7c4, 0x251f3aa738d7e140, 0xe8df2083f66c0a43,
+		0x463390dec3820a00, 0x79cca45661d3dcf3, 0x6f39158cf5073431, 0xd81f195f6a2df59a,
+		0x7333f45887f9ec82, 0x78579563fa943a09, 0xaccbfb29dd87fa81, 0x2be2b2b3dab9facc,
+		0x3df37a80303948d8, 0x6a936e2aaf70fc52, 0x83f714dc7485dea4, 0x3d72de8d5fb24155,
+		0xcdd79bbbc34f40f1, 0xd3341360328a5a45, 0xb8518313a9c27684, 0xf352429991196096,
+		0xcb85e97db10c3243, 0xb0e321d7b52cc7ab, 0xc526323db0abfd24, 0x769c113ae63ce941,
+		0xd32b3ff407e7c3df, 0x8c217c6e40013bad, 0xbd35270b99d7136a, 0xffbf31c76e45f759,
+		0xcc9ed99e9ec1d529, 0x65e1c413ae0dfb5d, 0xbd6b698344357926, 0x50ae6af3d914c53b,
+		0xad395e32c3a53c0f, 0xe6b8024126c5f5f0, 0xc59b2dfce986f30b, 0xe8842ba42dca7fd1,
+		0x5d777fc38699b417, 0xbd2011b8b609d3e0, 0xfbe6e74fcffecb2d, 0xf8b9e2f9123e886a,
+		0x1ee056af854f3a23, 0x504f5b2eec923af0, 0xa192c122248df3df, 0x53d9313e02779e88,
+		0xe69c1ad035e74777, 0xd70851194704da35, 0xe5c452a68dd7a6f4, 0xc3681ed9bf19a348,
+		0xbc429a31d5565639, 0x21b92ccea5879f61, 0x2cceaa31ca36e7a2, 0x5dc62830e9ae4ad0,
+		0x649b66d9bf566a9a, 0x15197291c1c5398e, 0x02c9626e161964ce, 0xe3621dfa516fbdc3,
+		0xfb28c6f6bcc317ff, 0xe1b1c3d8e5c3062a, 0xa874aa0d639d97e5, 0x5ff3b5f10393dc6a,
+		0x1ec902cf6778a030, 0x9bd984e89749aa92, 0x6b47892f757d4cc3, 0x89f35da531f2b0e0,
+		0x7a59db2e4c714e65, 0x0fc3c54bc21576bb, 0xb77b9153cc90ec40, 0x37f979f9106bb6ef,
+		0x644888eed2f23344, 0x6166f542429057f8, 0x34aae798a9f3280d, 0xbab8e80074a8460f,
+		0x3eddc51dacfc78d2, 0x065b85b3223b6270, 0x101b55109d9ac241, 0x7f903fb83aeda0a6,
+		0x37fa6df1da3c69ca, 0xe787bff5bb54193b, 0x958fb1f3c2269ee4, 0x16600718f0d17b13,
+		0x889e023e9207fcc6, 0xeb53d73c6d5e3e48, 0xb66db5cfe4965738, 0x1eaaa83d46e01b55,
+		0x11e72c51a812e06a, 0xe3d4faf4151a2d54, 0x2977164bd054ee50, 0x2bb417964ae10434,
+		0xa748e449e533c8b9, 0xdc1f77fc97fc5207, 0x5b3d72eae005b321, 0xe87c4c8d510f0471,
+		0xdf6fa9e8cb8bab27, 0x7d49c889a011fad6, 0xcdaf756884a2989e, 0x441ae39b32233678,
+		0x1fe7747cb316f089, 0xe769d049d89401a2, 0x32c447f961bec9b6, 0x5b62ee78c57753a8,
+		0x7eeed80bc765091b, 0xc2996479e2b208a5, 0xa126f11513dab57c, 0x5ecaf732330a7527,
+		0x157cc5064e645377, 0xbab56560e5726f65, 0x687634f78467e4ab, 0x096b678c1633f05c,
+		0xa4167cba08d89da1, 0x026dc3b8f5296750, 0x172405f3d37e3515, 0x85d3c915925b2298,
+		0xa23d75d315ea5a1a, 0x42807f2eaccb8fb7, 0x7175088476db6c3c, 0x70a0dfa1da804187,
+		0x57fb9a6c1e43c043, 0x3b0adf2f70739f00, 0xe3d0f860be73e0bc, 0x8e95a8e3b58649ba,
+		0x79c17263f6648dbf, 0xf766a1c44a3e432b, 0xe610e61876a821f5, 0x396201fee6c937f2,
+		0x060f2160aad8a594, 0x6deadf295420ccc7, 0xaffa632ca43bbd1e, 0x0aa409fd07c4a4a0,
+		0x5a99ba9ba53e1f09, 0x5524d142e8263248, 0xe34063967d4c6c3c, 0xe6bdd783963189f0,
+		0x7c7f995b8cf970ea, 0xf92f1ae0b7ad2272, 0xce9be278683efcd3, 0x4a93de2607ab425f,
+		0x379264189e0d9924, 0x6f19ee45e8f4d44f, 0x570c821d721a05e0, 0xbf6473464e9ebe37,
+		0xc74ddfd1c5006187, 0xd1d127d9a9d8fb8f, 0x9f29b4ee5bbe4c06, 0x46c2d2c27d85269e,
+		0xf509f4c85f2e2df6, 0x03ff510a494e7ffc, 0x908a2193f583660e, 0xe3f14e088b211777,
+		0xd6b63050800fb149, 0x9903d34def787e64, 0xfde921d00a974b68, 0xba6e49f3571bbf81,
+		0x0cf22f1826b48c6f, 0xa8ea623a9e62ce9e, 0x9ac50d186f735cd2, 0xdf027d0c93650d68,
+		0x19b56f06155ecfe5, 0xb31423179c5afafb, 0x04e0a0d525e776d7, 0x81606578aee2b564,
+		0xb2006e35c66d58c0, 0x7cee25a754ddfc61, 0xb585741269cc82de, 0xd17e6a490d9a877d,
+		0xb4318eb25fd2fb5f, 0xd5faaddd71fca3a9, 0xf2a39075f24c71b3, 0x3797da90992dba16,
+		0xc38eff229d4381ab, 0x46f52c3d119e74fc, 0xe4a2fa24eac85e77, 0x13d15ce6efd5393d,
+		0x892bef9b14a3f4fb, 0x27a2efde4a43d173, 0x1af9b5cd01d74317, 0xc22ee489bd34d0ff,
+		0x8afb26255bc80a10, 0x86cf8cc8ab8947d9, 0x09159d9a16f0204f, 0xd152407ee7c464d1,
+		0x5c7d21b75c79964a, 0xdad348365e8f3359, 0x249b0c3e2024bf24, 0xe15a872d45098f7d,
+		0xece8e943ea4d4e0a, 0x68a2a980354827de, 0xe01f9446ccb902ba, 0x2cd99fcab0509951,
+		0x7ef33aa69eced7e3, 0x97d164e2c4db308d, 0x5d4c91f3c8da4d58, 0xfa44798154c28430,
+		0x67c37d13e9890f8a, 0x89d6279de149e5b2, 0x27df86bb0b0197d3, 0x137c3e4d498cc284,
+		0xa113f4732273a92a, 0xdf8da3ebeb12f99f, 0x5635b54a53a09fb1, 0xcec7daf88fd8fdc0,
+		0xe91c91fab31047f1, 0x871dafdd4b03f348, 0xcdecc5192f537edd, 0xda5a1de97b257f69,
+		0x97d5b8d907a2af75, 0x212533d01ce8b73a, 0xde8885f1ef591a3e, 0x9f614a31890725fb,
+		0x776b57d999a0f7bf, 0xd68f2b92efb9a25b, 0x077b829762fea7bb, 0xce93cf80e0ff4a00,
+		0x8acc8d27b5472b63, 0x1d2381bb9a5d4a4d, 0x6aecb678d16d2191, 0xc50585e51e7c39fa,
+		0x1838f42724543d22, 0xff6e5864f68fcc7e, 0x180d980633fc7f66, 0x241fbc2784039615,
+		0x06583aadfcc26078, 0x229ba9ca0ff2a7e3, 0x2d78d6e59f40e2c3, 0x25c5d2e47e060077,
+		0xbeec2928ced4680d, 0x6493072329754cdb, 0x9f45b40995e38291, 0xe76d1d7ff86858e8,
+		0x5c19528b3eaabac9, 0xb27df174105e0e79, 0x3b6601c0b1fc3f1e, 0x258632c87776b69f,
+		0xfe33024e6d3a34de, 0x59dbe138910becef, 0xf36f30e18590e7da, 0x33218a0c561dfffc,
+		0x169c4a330d2e5b0a, 0x36cb903db0243d54, 0xf5c1fd3c24520495, 0xba11614f3fe7ed2e,
+		0xe33f2414bfa85053, 0x09e8937856dbfbd1, 0x161112850eff7fb7, 0x09ee4fd6e2b0b0ff,
+		0xab23917c23e39503, 0x6aeece6bcd90882a, 0xdc5cd8cca392fb15, 0x132c66862e433b0b,
+		0x2956fe7be1a0ee5e, 0xc619ff1850e75154, 0x37701530b00b0440, 0xdf694bd0ac2bfbba,
+		0x863759c523d105af, 0x858eab41e67ca9c4, 0x0a3d176950b77ccc, 0x9960b6c97616bdcf,
+		0x8dc03b861782976a, 0xc85cd167f8f0af0f, 0x53f5b7f9495ddd25, 0x340e064bfbf1649c,
+		0x519032b0423745c9, 0xb2f9d96b02aa4e8d, 0xd47572b89d70dae2, 0x73c91a9d92e1910b,
+		0xff09728f7f4b835f, 0x5cc8c8a50cc14354, 0xed60dbad83d43d69, 0x753de7a8f4cf959e,
+		0x118eff24146dcb73, 0xb9d09515d3849a97, 0xf3838c4686867b08, 0x7ab6d4c3970e2a8e,
+		0xf23fd98797ba1361, 0x32f991866463005a, 0xd4e929faad30005b, 0xb3beab91974195f3,
+		0x20872fd8245d94cd, 0x04eba62caa165e38, 0x472c08a2e8ebe074, 0x25e735656b708387,
+		0xcfcff01b9776494c, 0x28eb8128b399f0ac, 0x09a9a9b8401f408f, 0x9ff494ab10216947,
+		0x3054b012ef48ccf2, 0x397a1fa483ff3cbe, 0x692ba98d9e1a5e9c, 0xc9f20b70f1a84d0e,
+		0xf47cfaaa4d4eba11, 0xb13c9d11c51fbc7e, 0x9140a7f23b952ba2, 0x2696f8c0e4cd2497,
+		0xda407976a33142d4, 0x400c559910554f99, 0x7f3563d8effbe2a2, 0x5fe6b108f290e34f,
+		0xd39cf7e1099b8dfc, 0xe2b91a0281a129f9, 0x4a22fdd090443968, 0xe35938bbc0123825,
+		0x2b76ce0229a830f0, 0xb5cb6a2a8297add7, 0x0b9c7a51427e65da, 0x9e44caf1c8078fd0,
+		0x49ffd0cb9709da97, 0xbfd11f5461dad27c, 0x5ce38fd273057e9a, 0xf3c7c855857b1fd2,
+		0x410ed780d382c855, 0x3572736a7760a8b7, 0x2e361c6a4d43d81d, 0x86fc09fb6e9c5544,
+		0x8aa5f49a211b87bf, 0x53b882a6c8fb195a, 0xad42a362847f729a, 0x7de877d179a2c2ca,
+		0x4176e29dbcdcb028, 0xbb7f8230d0f7cfb6, 0xaeadd79182462cb1, 0xe5796033e8237a22,
+		0x7db89fce1ea2f894, 0xba60de536e12ed2f, 0x4a681f960b4ee331, 0x94fe0c9fb1b93009,
+		0x9c71784e3e340d54, 0x56bb6fb6fd58c094, 0x995e80a3b172d3cc, 0x52efd93f7eea4216,
+		0x8929654015afbf22, 0xc591dc51fca51446, 0xae1ca291b38c0e4c, 0x5593a9a9cfba1eb4,
+		0xfa324ec1d6404cc2, 0x0472202b1c0f6c4c, 0x86d8d6f7b28de9b0, 0x2701fa1ccf58e5c7,
+		0x6ccb941870581af1, 0xff605e8ce2a4aaf2, 0x35d89ba4ad40f8ef, 0xdbd1da42d8073195,
+		0x1986257f00e7c38a, 0x4b4fa2d8fccc6aa4, 0xe68719c87971ff9d, 0xb25c9190cac3b740,
+		0x5eb9ef31f744a6d7, 0x4f42edf67663cfb4, 0x87fcec351b8e1ebb, 0xe5504363df947b3f,
+		0x858d22e6ecba77db, 0x6c1ec8bb6dbc76d5, 0x9ea8f81bc4397dcf, 0xcd5bb5e34dcd04a4,
+		0x56d3fa249ca3edb7, 0xcf31e8066fe86d74, 0xff30b84eb107deb1, 0x1e480944038c2016,
+		0x41f6901e3ca80dd1, 0x4aab9423aa7c3c7b, 0x71931f2df34d2ce8, 0x73275215cf72f289,
+		0x7bf98c2ac1873146, 0x324e01f521b2b6fb, 0xe6b2dc8cdfa9a462, 0x2be8d6c007435531,
+		0x2fa02a8f3dda888d, 0xc1915c92567b7748, 0x2a6ac5dc90731fba, 0x83e3c651a85f58a1,
+		0xde03c55994eab6e5, 0x77f36e8f604e6f5d, 0x3f16cffc513e211a, 0x67143c41a55c0c05,
+		0x9585404e6f300bbd, 0xab38634ca97b98dd, 0x5712b33d705232ed, 0x35169f6dab35e12c,
+		0xa38bc8fee585e80e, 0xe6947b6768686958, 0xcde80215a4a5c604, 0x1d4697e4a27b9d00,
+		0x2f39f6f2f8910294, 0xc93f5295094c8ff0, 0xf1cdfa5bb1d781ab, 0x146141c76dfa488e,
+		0x105dd084127ca450, 0x3e15758b0c319fd7, 0x91d862626264e993, 0x54839392d9eb9bd4,
+		0x562d5f6f88153d8e, 0xf6c9aa8424cfa9ac, 0x1958a75ec9918aed, 0x7f97fb6fa9a500c6,
+		0x7a4e4fcfaf6f0caa, 0x59e3f3a5aebce667, 0xd0d528870d665806, 0x389df4d39cb9f67b,
+		0x4d0d8975d1b1f38f, 0x6a2849b31b0dc6a1, 0xf4e01e9a2d2c2708, 0x6ca3cef8ddfa688c,
+		0x6a40374b663e3d3f, 0x4d9e33f9f8cbc685, 0x5ddd1813ab7c3de2, 0x653e8bef9bec37e9,
+	},
+	{
+		0x9a34775c83fdcfb4, 0x9f55b59cb1e79091, 0xdc3f08c0160089a1, 0xc098c96031c116ee,
+		0xf8e8ffd3a991a5e0, 0x76dc78d933d952bb, 0x1d3e0c8b621fa26f, 0x1f760b2cfcc978d7,
+		0x77c8350465bdc800, 0x8d05ab008efe99c6, 0x53690e103cd9e8d8, 0x3a71f9f955c06c5d,
+		0x559f54a87f52c8c6, 0x4489cc43c67a0311, 0x06bf096d042050b5, 0x6e06c89a3b0d2693,
+		0x6f40f35f93f2f174, 0xc6de2c1d44695cea, 0x67415bb7e7eb7def, 0x5d4aa132df20759f,
+		0xf0353c57ea0898f1, 0x0033338377a43ff5, 0x9c522c709b1527dc, 0xebc36a745c546852,
+		0xf953a48e282d1e5d, 0x64ddbb71e781afc1, 0xbb851d1bd96de08f, 0x45393a376ad3e5d8,
+		0x233c7055460b4960, 0x8c8de19f89d3070a, 0xadb1532a72b786ac, 0x407e408924d8c597,
+		0x21231e5565d3e27a, 0x67b257a750a4d3dd, 0xa4d1476ab39a93b8, 0xde90f93fc2e935b9,
+		0x03f00868b6922a5f, 0x65d8ce8ad96f987d, 0xcafb1599a22a4a8c, 0xed85579851db7cee,
+		0x3a19fb49920e7e18, 0xff516977cf46c00c, 0x7c7582d122e8651f, 0x9be223b0f16e73c3,
+		0xd96fc1e8b2682db4, 0xf9f3cb1ddfb84406, 0xe8a3ad7f7a951086, 0x2ade6fa07468032e,
+		0x10f38598424eb268, 0x958b4f4bc2f455c1, 0x3d6d6af31660ec8e, 0x0f3c7f0e48cc56a2,
+		0x2edd035d60586492, 0x02b735f3ce7f826e, 0xe798022be06840aa, 0x53a5517b0d2a4327,
+		0x423ffa871a06436a, 0x04954002596efabf, 0x3e131f9b43bcc72d, 0xe384cad1ce613807,
+		0x07c44ba43dd633a6, 0x1181380e519dabb1, 0xe8f0f5cdcf226237, 0x0f5c0286d4e5b78c,
+		0x2e12c33c1903f412, 0xd67c3fc5a1dfe7fd, 0x34ebc767b5f24baf, 0x29ece74540856502,
+		0x16a586ed2c2ce8dd, 0x0c244b63f317f163, 0x31430e3f505c0613, 0x725807c4b1cf973d,
+		0x1c690ed2cead52e2, 0x7710e9a07521a1ca, 0xfccd531a07f5a8d6, 0x92f0ac42a9219296,
+		0xa34631dfdd3bef37, 0xabb3c75fcbdd0b76, 0x23de541823b9f923, 0x185a5b7775dec276,
+		0xa7e16ea29ddffa51, 0xb67c0537cdc2a014, 0x9d332da76dc115fc, 0xe2771ce42c92ce31,
+		0x9eec38f63163929a, 0x45aff3daf494aa87, 0x6edd7deb63ef6e58, 0x119f1d1f9c4ffa5e,
+		0xc2432957d9420dcc, 0x71c38e017ceff28a, 0xa790182f3946130d, 0x92350615948778cb,
+		0x68a3026c8e5c05b8, 0xfb15285310751f46, 0xcfaa7c2dfda6d73b, 0x59e4cc67cdf40a4c,
+		0xa7d12a94818f36b8, 0x06b8c309605f7a5a, 0xdef4c3d67ca27d33, 0x6630fb56ea207d13,
+		0xb42752f31f3bbb3f, 0x70c607efa43aae74, 0xdc54198d87887fc7, 0xd27c6ab613d5db27,
+		0xa5a49e0af0fb03f9, 0x3ef3d8dc4461cf5f, 0x314c279d34646ffe, 0xff5cf30426075ac7,
+		0x9bf362146349753e, 0xeec963378d1dd765, 0x18f759e28b1df4c5, 0x489c97f8b51e4f2c,
+		0x5825f88c1579903f, 0x9537337ba56f2d3c, 0xca192df8f56956fb, 0x6d3650ddb25d257d,
+		0x6f58fde6263b0dd8, 0x61730b5d528485c2, 0xa7390d105142bea9, 0x55ee669528ce3202,
+		0x999317ed5f786e5f, 0xcb5347ecf60ad777, 0xa698031b9865490a, 0x9eec8780cfaae146,
+		0x6a200ed3ead82ff2, 0x5a0812bfd2c5dfc0, 0x2430900251541359, 0x478c96f025b15a37,
+		0xef3ffc58882a7299, 0x247a60bab720bdf3, 0xe1bebb40b2406ef9, 0xd14c5b5879494751,
+		0x2788c5c7c7c6946a, 0xbec9d42ce1836e18, 0x76b775d39b559c8f, 0x5fb5eac91ca1399d,
+		0xf20333c973e1946f, 0xd55d231cef03e7d9, 0x5e57e6f2323752f1, 0x39380aa036b0483d,
+		0xfad21617b276d3f7, 0x8867e19904d80905, 0xcd1a3c9a37439dc0, 0x3c3cb42f2a73eccc,
+		0x97ad399fe7ccb2b5, 0x1e31167bfe313033, 0x52a7e768ed2ecc2d, 0x6ab3e73d8d16c8ad,
+		0x40b4d1d82ee64081, 0x397113c3701bbbbf, 0xd2af3c6d9b3d4c19, 0x5aac55d680fb8c0c,
+		0x60727d21687eef84, 0x13c75fbfda13ea9b, 0x741ea9220fbf0988, 0x55a777532e787c1f,
+		0x02f052e8290040dc, 0x8c5c78a46eac5888, 0x76c7a4da6e4738f4, 0x4dd6ee09648ebbef,
+		0x953528404e104aba, 0xdd6ea935f09c7761, 0xa390a47c28ed0c49, 0xb5879316f55a551c,
+		0x0303da303525e572, 0xf2fb0cf884f547b6, 0x638a30e5042347a5, 0xa0e65bfbcd30d02f,
+		0xa7a21f3ee54818be, 0x6e5243c32132326f, 0x8cc042de78b57252, 0x4a673fd43f9e667d,
+		0x063ec717d41ff499, 0x71f4d709537b56e8, 0x8f9ea6fccc6c566c, 0xcff03dbec68bc6dc,
+		0x0b31dc23ced54dd5, 0xaaedb701c4cae629, 0x69a495f8d641b407, 0xc462d5a2f4633c6c,
+		0x5d33c916c478d653, 0x7564c9b1381e036a, 0x69f12449f4dfc47b, 0x07ecf7a3ca2524e1,
+		0x2e35eeac1e03148d, 0x6e655e82daa3f381, 0xc64eccc853005573, 0x955dcd5a260b1067,
+		0xc381f1137fcff2a8, 0x6eca057b769fa7aa, 0xe294449136d23670, 0xbd15e070d29d82e3,
+		0xeecb9eb938acdfd3, 0x82fd881062460ffb, 0x835b37afeb6e8b5f, 0x9e7a6d5bffd9ebba,
+		0x8b2f54940fba34ea, 0x295709cd5ffcfaed, 0xc2c5388b691bbb78, 0xf511b8e205f6dc4e,
+		0x16b8f893ada34645, 0xedee1f9ab901b89c, 0xae4156e08d8f6625, 0x3a9a3d0dfa1a61ee,
+		0xea119bfee8a5f13c, 0x3af6fceded5638a7, 0x2cbe6c1ce08b2dcf, 0x17f3751f97acd7ba,
+		0xf85d4118af212761, 0xa79ea16937537fc3, 0x21a7b6380778034d, 0x759e881d2419113c,
+		0x3134fe7ac35a7bda, 0xf81817681b8b2e62, 0x7aeb68479a88f676, 0x69b65c9046de1b32,
+		0x9d449be1a4acfe3e, 0x73bb9e9d29e47539, 0xa90fac37731098e5, 0xb568173763a8e19e,
+		0xb5b5b72b29b2338f, 0x6ca13ac0569a2562, 0xebc16cfff1bb7132, 0xc375cf3f10ecb1b8,
+		0x88b1fd2da1356dab, 0x686a347c4b43791c, 0xe386dcb0655bdb74, 0xec9323bf55820cb2,
+		0xb2d8759bf80ea026, 0x61118155970bdd51, 0xaa857777a7c47738, 0xcc0d4f700323b006,
+		0xa20bc75c9b55352e, 0x8e6465bccbfab597, 0x9e53344163a0ad3e, 0x1ad2999cf210f380,
+		0xd1db53e962b09a36, 0xa056c468015ac25f, 0x80e110410e285eef, 0x34833bd7f3ed3e57,
+		0x44b1627194e2f16b, 0x55d138506aa201cd, 0xba3dfadc4197288d, 0x43b2719b9e6f1048,
+		0x9b850bb983440d00, 0xdea9b4c3aef235fd, 0xd3518c0db08603be, 0xb7b0954e29e54c0d,
+		0xa97f0687081c48b6, 0xc698a9583813947d, 0x46c15fa1bccebcf4, 0x267880f6fc4f6763,
+		0x0a76b161c5d60f24, 0xdd5f4ba34de07e9f, 0xf26f0fc3e49231a3, 0xcfc11d59e6af7d4a,
+		0x300f431144895b12, 0x31ad468fef555594, 0x9b5dd418ee31cd38, 0xa35a098fc7d42624,
+		0xd2d426b04c565171, 0x933a4142e9bd9f6f, 0x209628869afe6219, 0x55f97d29ba6b00ea,
+		0xfb6df2184f3199b2, 0x1d8b20014dba1494, 0xcf00b8bfa8909688, 0x32fcaef79da6f33e,
+		0x4219dc3c0a595385, 0x40b8fe142dc382c7, 0x29db7f21714eba5c, 0x19285141e81652bd,
+		0xd3bdcc2adb95fb88, 0xd57e1dbfe8dcab3d, 0x0834eb123be5cb80, 0xdf97104f508b1118,
+		0x80db9b8a9de51501, 0x61deb4a104deab4d, 0x22f8ae6cda5d7987, 0x63b02aa86fd6077e,
+		0x178646de2c6353fb, 0x2a3ecadccb01daa6, 0xd767b030dfa99148, 0x9a14dce87af388da,
+		0x037a9690f4bd00e7, 0x2ab06bde8ed13086, 0x21f657926c6de326, 0x00052ff4661e6a92,
+		0xfac83efb01c39d0d, 0x2a3d70c686b081b8, 0x16a54fd913e7248e, 0x826bd278eca8bf92,
+		0x8b089505059ce3ab, 0x80155260efd6d3f4, 0x24326449dc0c1dbe, 0xc569bfbec304f494,
+		0xac2187956bc60d9e, 0xb0a2eb0f94bab9c7, 0x80a4819fc5274247, 0xd3a40d9777990573,
+		0x896668c0029520c1, 0x51dacec221fead90, 0x8644cd937c01a5b6, 0xcec864e608efa846,
+		0xaa365204fc6e8c19, 0xa533a7a5d6379adc, 0x67a9ba30062f383f, 0x5031d2656c746425,
+		0xbd6ed6786a642ff6, 0xe636b6e39c4606e9, 0x2525adfe6afefb97, 0x1cedea634cd3287c,
+		0xc591f0946131a0db, 0x87306271eef5871f, 0x6946bdd94e9dcacb, 0xfa55c26358d3f928,
+		0x8ec4cf3d286cc1c5, 0x0a85fd056862733c, 0x1c001a8b904b598b, 0xff1ed7b9505008be,
+		0xa80e82454bc16c76, 0x2590872f9ef4100f, 0xc653a15638de7ec1, 0x537eaaf4148fea76,
+		0x9443ef77cef690fc, 0x85ef7675d2cd4549, 0xb084744713c9cf59, 0x2f45e9f5a4616ed0,
+		0x153ae3953efe68cc, 0x38251cf8308a7d6b, 0x219bdccb16ba5270, 0x80372a179d51186e,
+		0x1dc4c4f8271299c2, 0x3f70f71deca3a3e8, 0x9611f626632b621d, 0x9c02f12590cd49a8,
+		0xd3e647df1db9b105, 0x81c868b960b7e3fb, 0x684eee38ef91de2f, 0xeeaea78f0ce99ed1,
+		0xaf9f98bec093abf0, 0xff8e3b8520dd620b, 0xb514167dd70ba024, 0xf46623fbea1badcd,
+		0x8fdb62b11f68fb72, 0x08abf2ea1d2be4c3, 0xc55efc02c2d24a6f, 0x4e7f9195f050980b,
+		0xa22670d47754c579, 0x31b361efe61bcf2b, 0xc775a1d8397eec48, 0xfba6c30ace1adc87,
+		0xe162efa56b3dce11, 0xa37bd8ccde0bf3bc, 0x68f4ad93dc3d9123, 0x0330fbedcfd3d5a7,
+		0xcb820d7a4f189b07, 0x9fd00fdb809ed1ad, 0x954daeeaf0007860, 0xbf1c4bc755d6a988,
+		0x1c011ad51f432c8c, 0x5ae67431ab8524c0, 0xfee46ab25d1ba6b7, 0x61dacab22a149c14,
+		0x3b7d0bc3f5b68464, 0x9ff4de78f901bf4b, 0x2015abe93eff37d5, 0xed02916acd69e5c8,
+		0x08dab073c454494e, 0xb0ca8aef7c94b237, 0xd2a50dc2bce65b92, 0x534a78b88b460ce9,
+		0x59afd23d92e3e9b7, 0x82fbfb540014caac, 0x38c4a7d995dc12aa, 0xcc9a50b42ecdf9c8,
+		0x402491b922bd8490, 0xf75339b1c71ac57f, 0xcb08092acb0ba6c8, 0xdc6000f8d88c8bca,
+		0x41ad9b86abd988e0, 0x33471883201f3d1e, 0x7e516b004af8a6fa, 0xaf0af4d6eb269f2e,
+		0xcf9aaf1c75a634b5, 0x8bcb499070622f49, 0xfaff3117fa9f54b0, 0xc6e9c3a19aa1fb26,
+		0x29ee8ec8460c4ecc, 0x9a0d634f550c0eef, 0xfdcdc7304c6f8995, 0x30003eed70e29c17,
+		0x4a903b50aecf4584, 0x6fbe760cec0e9d00, 0x58df0fa137652592, 0x312a7cfe99811b34,
+		0xf5a2096736da3116, 0x9fb8522b9fea27bb, 0x78ae1709ea6a3335, 0x83ac0d450d981375,
+		0x8927a489eab75fb7, 0x0efff6c3accb8ab6, 0xeb2f666014bd4472, 0xfe5d944782867843,
+		0xe75e610fd6364d8b, 0x17cfc9521f6922a5, 0x45ae4c64401d3f41, 0x789fedda179aebe1,
+		0x650f57560c766647, 0xda75a8f2558c2dff, 0xd6b981fda5ad4508, 0x53573217d3c38904,
+		0xf8b0510a039aaa39, 0x0eb2866445fe4eb0, 0xad1b9cc2d6b6f9b6, 0x8e57b1c792ef6f9e,
+		0x572bb3462dc70cfd, 0x2ef21ba7e78eef69, 0x92d1e160c6240aa7, 0x815484c9e1cad259,
+		0x6144c8d1cfbff0f7, 0xe96164fbf831696f, 0x0775783ff1744ea7, 0x51e9df980b1ac20d,
+		0xd7491b5bcdd1504b, 0x6a949b1f3696a16e, 0x0dd1d2e6789fb6c5, 0x797c138647067deb,
+		0xa1c0fd71af0ef1b5, 0x657b255005c771b8, 0x7044b284bc098393, 0xd2878b1a767dd37e,
+		0xf8028f6ec1210be8, 0xe3afe00c8730380a, 0xe88e66d5bd9c6072, 0x505edf19c9aae314,
+		0x05c4727e051d46df, 0x5277c1af0bbdc6dc, 0x13de8ea3d4ed06f9, 0xc270f8c23604b755,
+		0xd78ab138f40dd078, 0x4dcfffc12c46acdc, 0x1d4e12d4844e81d6, 0xaadd01b4594f919a,
+		0x17c8c60b7ab4582d, 0x0ffe073a73669931, 0x4ed97d1aa8262339, 0x091045819baced54,
+		0x61581cd09327924a, 0x6985db4f40b4a4d4, 0xaea3c8e1f778c6c7, 0x1ae3e3934d9e3380,
+		0x23a90842d47cf6de, 0xb34c99f684358ef6, 0x3f1732b7cce48359, 0x575c410eeb83e0ec,
+		0x438d3a5266d5c3ec, 0xa245aaa90ce4ccc4, 0xbde2684f502efa8a, 0x106860aa8b69533a,
+		0x7faa244ec6e09e3e, 0xf3745d2a3ba930a6, 0x6b3558092f775e57, 0xde744f42b8a552f0,
+		0xff7c2bb82586d55b, 0x0b43547bb5c3528b, 0xa3c8acf882db612e, 0x9aaf50f2a022e0a4,
+		0x67586380a5a07b56, 0x7c777cf44c85c486, 0x92249628061b3faa, 0x40eff9c55457018b,
+		0x8093ffc7380c7420, 0x43e221f62f5e8382, 0x92b51f110cda1cdc, 0x92d5f9a8805a65e5,
+		0xdae18dbb84346fcf, 0xa563f22144581452, 0xd3932ad32e4fa002, 0x8ebcb5b26ba04436,
+		0x307eadbfb7baa37a, 0xa8014d43af069ff4, 0x2452d450008aa65a, 0xc796966b136b805c,
+		0xf93cdc88af6d1d12, 0xc8d51a6a9703dd11, 0xbf7c29cfcd3922a5, 0x04cfedd2fa65a27b,
+		0x489b769d65d94823, 0xbd54c9264cf47c04, 0xae02ec3674d2fefb, 0xd5a2d9df70dc723f,
+		0x74b17bfb5535c9d1, 0xe21c886b8157ceb0, 0x1858ab074294f42e, 0xe7e9aa0f266aa0e1,
+		0xe74f418dab84d1fe, 0x526ba02bb19d3eea, 0xc43ec7367d49e931, 0xda61b59f19c5599a,
+		0xa1d24fae694bc97a, 0xf2833cb43d61dbea, 0x9d408a690edcb784, 0xccc4686513f7c360,
+		0x9a2e2fde148f867c, 0x14926e986a62fce9, 0x68ae420a9c8eddc3, 0xfc496fc280a8f85c,
+		0xf047b3543d986a39, 0x0840ee50dd949443, 0xd776bc0905eedfff, 0x166929e702e95238,
+		0x4240534eb6b94710, 0x8bda95b0457dd3fe, 0x84e3547bc7416325, 0x835d1bfdf70617df,
+		0xf81e2a4acea2bc6b, 0x682f13f53bfe0d0a, 0xd1e672f5f0089bca, 0x7e979cddfb79152c,
+		0xbd4d39744e757d3f, 0x677fb9603efadfd7, 0x3152f44e9cc366aa, 0xfa32381795402218,
+		0xe9a696b75397b8ef, 0x5ce39e3de00409aa, 0x60c89904df0b93cb, 0x5f0762a4b3b69b5c,
+		0xedceb732732bcbfb, 0x1ad2af842bc9c5e1, 0x816458bb341f459e, 0xebea27fe78b67609,
+		0xc7fab6ecf6a4cde3, 0x4b790f08f5167fec, 0xcc844d96845bdd95, 0x1052550bb3a5ece7,
+		0x25dc806083907ef2, 0x7d29e314e6cfe598, 0x76da14ec38661165, 0x0c9578a105d383ca,
+		0x84f714baaf261575, 0xcfbde399081f5b1b, 0x4ceb386a70c7b4da, 0xf8742b1480a8669d,
+		0x9cd8b5dd4f8d2d04, 0x88cffcbffe595ed7, 0xa323bf798c2e8ab7, 0xe72805ed48a40c9e,
+		0x44c5cf5f13371acc, 0x6e0e4622ed85507f, 0xabd0d0a52b1c9191, 0x446420e26c6733d9,
+		0xd1dca1091ceecb79, 0xc95d7e0b17ce26f9, 0x8d4b1cdd7c5f0e80, 0x6f37216a8de61236,
+		0xcf3742f1622089a8, 0x97ac86595aed1079, 0xdb09d9c90b8b7f4a, 0x784e397a5b157ecd,
+		0x052ff4a8ecf70b72, 0xed80355f7ed04ce1, 0x0311693a01c9d5c5, 0xeae29a5b3552a824,
+		0x6c273537be4676b0, 0x714d61bf871e227a, 0xf58bcdffeae9aa87, 0x143907740e3acdcd,
+		0xed2ffa1d5a61479f, 0x657e38db024e3a4b, 0x843e6981a88433ef, 0x02aa23bdd2aa0a7c,
+		0x0377fcaf985394f0, 0x2bb0b9fafa7fbe59, 0x73963c286ca898b8, 0x20b2063ba33fd390,
+		0x712519d33e5c764b, 0xfc4a562391e3f921, 0xcee09c491ea1237e, 0x9079cac11bb682fe,
+		0xce236855a8ee088a, 0x32f3de973efed26d, 0x27b4cbaca411f8be, 0x02d19039db5df7af,
+		0xb288d3a3c6401c11, 0x48c7feab5dbd92b7, 0x408216550d99648c, 0xe83e1ecff8a60ba2,
+		0xbde0fd6b1b4100d5, 0x7baede1f2b6073ca, 0x42574af1c2853507, 0x25716c29308e8e48,
+		0xb7ad3c50aeb6f051, 0x8b735fec41fcbde5, 0xf8a8098dc49b083d, 0xc80c9132223658a5,
+		0x9f4374afabcbb372, 0xba2f383b8d98e63b, 0x918b6956f2197c15, 0xff5775ba9bd04667,
+		0x7841d8b8dd4ab9d3, 0x724d36247f1a16f5, 0x35c872dab14e4747, 0x7a5051faae8c1069,
+		0x363bccfe9036ed3b, 0x65401f536f3b36e2, 0x82df86d896e27990, 0x0b461898a1197c1b,
+		0x96ace86f75fa1667, 0xc11516caff349476, 0x3473de76261a7a88, 0x652906cc48e264c8,
+		0xb75fa25b343fb022, 0x733e97f67647ffe1, 0xed351cb69cc1a0d0, 0x8f9ad007218ec90b,
+		0x5a59a553503e3302, 0xade9975a3166894a, 0xe310de037a67388a, 0x18ce1ce641290d8c,
+		0x14d33286fd83ffdc, 0x779c8e20015ddeab, 0x487b193cdd4cddd9, 0xb189b3f39e75ff60,
+		0xfd08929d3b98c19c, 0x23ae30c463948c3e, 0x9f3662f5c1af4f12, 0x14b1fc08c331b887,
+		0xdbb9548b4f3ea85c, 0xa0104582f27a1b50, 0x26469c1054702075, 0xcda42ad2bf7c0744,
+		0x5f41545758d97a6d, 0x56605a96b6e22dcc, 0xa17865ede942592f, 0x33efe61555164210,
+		0x7672201ce40a3665, 0x0613e8721bd74540, 0x64c762d040aa4dfc, 0x4d0ab9827e30186f,
+		0x04d1176b8f530092, 0xf2483343e6c54846, 0xc05c07c5196768b8, 0x0d6e8b55d7821e4e,
+		0x875d4ea28ca9c577, 0x9f0183cf029ce2a1, 0x8174e500cbfd5f82, 0x4927c6f08bd000bf,
+		0xd706ed752480d910, 0x461ac1550b5da6a6, 0x8ae17eab0bf7e67d, 0x0a0dbef89d91f987,
+		0xe45f39a9bcbee3bb, 0xf2a55b1eb89900a0, 0x9a4b43f04276fd42, 0x7828f34202a71902,
+		0x8ec200d2f3ba6a32, 0x5ab5a9f422f8370d, 0xeb4d1f497e74cf04, 0x03e54a25e6d38d28,
+		0x39d390834e307371, 0xa53f0de1987d92df, 0xc592023b5fcced18, 0x2e3647390541b770,
+		0xcda2565677e3bc1f, 0x610e8fc2b2eb9743, 0xbdbc38e1b572211d, 0xca39454a5de4f0a6,
+		0xd3c7e1e40610da09, 0xcb4bd1bcaf84eb8b, 0x60e374ddba6ded4c, 0x8f84be68358c6805,
+		0x4660a804ca7c62a0, 0x3c2d94107a5e341f, 0x5af2513fcc5fb539, 0x5a28ec2b2f51dc2f,
+		0xbe8dbfaf72e785af, 0x8a38fbf7f6157c78, 0x1d288654a68edaa5, 0x93b2d8c6f11a4590,
+		0x8c727904e03447db, 0x3e7c6658d228b4c5, 0xf9836e3919a9b356, 0xccc18c67dcf728f5,
+		0x45791cd1ecb151a6, 0x101b86eff087fbfd, 0x4bd97cb3df9bd7ae, 0xb2a2ae059172c1a7,
+		0x7695e12eab40336d, 0x284f9d2a2fce45a8, 0x16af3ffdba023a0b, 0x0f60a27ce2215e9f,
+		0xf732a13060f667d3, 0x3a9a4d21977cce71, 0x9c3c0170ccdd2269, 0x27be0ee2ecd6a53b,
+		0x9fcc896088938370, 0xbf9ecb889fb0754d, 0x953865d96b54fa3b, 0x9bd3b8cb5a75110f,
+		0xb02d4a9b8296dce2, 0x00e6117828d13e75, 0xb43c2ebd72df5b98, 0xa4f7e5c1fd611047,
+		0xe5d28dd060507916, 0x6b94db791f123c5e, 0x7bfc6bba0214aa56, 0x81e4d1edca6f99f2,
+		0x4673909439dac6a9, 0x69a0ee0234d18a26, 0xe401e001ad5aae0e, 0xe607f0e47cc0e204,
+		0x9f1b08bb2e86272b, 0xdadca400528a8689, 0x04fa340f7d527c6b, 0xbbdb264f2d705cab,
+		0xc92ee44fed2a025f, 0x7dff7fad54be2791, 0x0ee3247548d5019b, 0x5b01258143f3748e,
+		0x68f5eacf7c351ffc, 0x1208a474a1315793, 0x6c627ddf96b1ccb1, 0xbd51b765d630dbce,
+		0xa1f97a652dd2e248, 0x253fc5ca90f4d424, 0x32e3041e79530ab6, 0xc1a84dbad99d36f7,
+		0x7405c56e58732f3d, 0xccdb1dcbf475caed, 0xf841886e0e576e8d, 0xe8a552e673308f12,
+		0x8bba9c17ee8277e4, 0xebf2bba05a84c57c, 0xc947c4ec17077cd7, 0xe86ef0191ccb738a,
+		0xd6d4a759e83b6f9f, 0x545f1eadf397306b, 0xb7466f47342456a8, 0x921d8795f26c33a6,
+		0xb4746bdff7b16006, 0x8b4905523e82d1d4, 0x0cf87b6f0044f2d4, 0x84f58d4a5c7008ae,
+		0x74c196b2c24b8f43, 0xb3c3ad404cc2e276, 0x2b9d8eb6ee866624, 0xc9ac4d7feade76be,
+		0x526402e831a809b7, 0xefe4c82499ff81a7, 0x39758a70a65fb402, 0xcf0c301b02a523be,
+		0x38af72e2fedb5816, 0xf63ec35029cdba18, 0x3e9a38d3f2a6145d, 0x63e5310ce554489a,
+		0xa4aa1253b8b473da, 0xe310c18b462056cf, 0x2936b22a54bbb4a6, 0x395f17ef5cbd621d,
+		0x811e1ea706bfbf57, 0x5592e0fd56cc599b, 0x4adffe7ed61ee02f, 0x12b789afe51e33ff,
+		0xfe62a2e098409184, 0xe1b7a1bf4496a165, 0xbcc2a37abfdc0898, 0x0aef429f19422fab,
+		0x740420a7e22d1c3a, 0x9551fc2da81dd7cf, 0x91336b4e5f3da2c9, 0xea3cb726735357ea,
+		0x80be200b282dd40a, 0x5bb1d5bc607ff889, 0x905e048bf8663c32, 0x9bfba936600ce295,
+		0xbf20784eeb15eef5, 0x032294cd5e8f3dd3, 0x732d908fc5f0132e, 0x6d1bbfeb5106ca01,
+		0x3b8936b36dba46cf, 0x44e5f15e9ceaced8, 0x2816b49a8c58da47, 0xc0e214279df2575f,
+		0x2e841ce873b77ceb, 0x32e8fb11e74ae5ab, 0xc28e7df9492a3360, 0xda738cdcbfc623bf,
+		0x6060db61521b7e99, 0x92de9af08c04e821, 0x9e5219b8704a708c, 0xe8d3bfdef8232f59,
+		0x92f672245f3c8b20, 0x9dbcee60bd2c6edc, 0x4e1e710773acf6aa, 0x845dc04888c7368f,
+		0x2dc436e4b5659145, 0xcdec97b3b2aee323, 0xce2578267620fb1c, 0xf08786211f3faa3b,
+		0x8c4aded2a5e269c2, 0x9cfcde129b7b4588, 0x522c1b7903583806, 0x5718ac8c6a0e5437,
+		0x5d140284f14e4e51, 0xabe35b558dba3fe4, 0xac993ba92966680a, 0x14dfbd0c4290ebb9,
+		0x2dd10e76a3139afc, 0x5d502d9ef5f733b3, 0x7fc6572e28e7c981, 0xd8e6cf7336cf1418,
+		0x45355a807ec60d5a, 0xcb6ee9824d322a37, 0xeddb56d3864993d4, 0x55d3dbe5c674b574,
+		0xc2af1d21f1b20b2c, 0x3817d252266ece9d, 0xd7ac014d92caece1, 0xe146d0d332b5125f,
+		0x330bdd6dead48717, 0xb5a11d33d231e7dd, 0x39b68a28ccf793bb, 0xd6c9d5dddd56ba77,
+		0x4d51b85dd81e71c5, 0x71af9a4d0f02c4b0, 0x38c624677eded497, 0xe31c9f6d5a14568f,
+		0x898874876798bed6, 0xea13adb60f25c5f4, 0x8c650767f1a9fc43, 0xa351feb13ef8776f,
+		0xcb7011e60b9c67b3, 0x1ac397ea426ea6cb, 0xac5b12a4d2c2d25f, 0x7d83a42968a95c95,
+		0xf3250abd4b6e4073, 0xa88417c1828b028d, 0x866645de5d7fde94, 0xb61114a80117b22e,
+		0x96b83908a988458f, 0x693710fc9183db7d, 0xdd9d952c53654ccc, 0x2d0fe91cfebd1b99,
+		0xba33589f5411ff17, 0x70049f0251de5130, 0x93a7071c18308b6d, 0x7ccbf9ce91e980b6,
+		0x352ce8cdafdb9589, 0x95dc69b92997fd8f, 0x744722999a3b6374, 0x319b53ab4d4212a2,
+		0x08d4b1981bad3a42, 0xea4199fff0cc90d0, 0x7a5d02605799d2b3, 0x5ecd07d199d2e6cc,
+		0x1956627a76583c5d, 0xc67867e33fa96057, 0xf1789d6ac6021f25, 0xdf0a98074d3ee1b7,
+		0x5bc69606fe960681, 0x6cfa8a91493070c4, 0x1208379af5fbfbfb, 0x80a5967b2a550529,
+		0x087ed599982e4ae9, 0xca8bac2254d24a8c, 0x57a40c4a01756bbf, 0xae1d5a9a3e6a270d,
+		0x39fa9e0acca46b48, 0x081da9fc0f3cd33e, 0x751f7ff853965027, 0x96fc0e9cdd4f20fe,
+		0xc1a167c80003d8b0, 0x6f2a5374fe1eaf09, 0x9a17e1d082e9db68, 0xb09d3ea4688b2152,
+		0x49539f659a27c342, 0x5ed332327c18621d, 0x6795a2dfb94c36ea, 0xb0859f63b1af3de1,
+		0xbe3502a0a0807eec, 0x7fa23425bab53a1b, 0xca0ab57e5a44aefb, 0x2c592aac0a4f0b98,
+		0x75d16efe2cff9b09, 0x6e30d8e3c1c5516f, 0x44ec9ccef7d158ec, 0x934d0054aa75d7d0,
+		0x20c9f3f1ec8870ae, 0x775bf94ec9489f1e, 0xdf2cc06a084d1d0f, 0x72d871537adadef0,
+		0x83bedf9ea6f6e73f, 0xfc7260919ca1fbe6, 0x8edbedc391113c74, 0x575412bba6283ee3,
+		0x346f82219e85a61d, 0x24bdd3ea5930efc6, 0x0a47a2d9797ef0aa, 0xec06ec4fbb990ced,
+		0x79703ff9baedb29e, 0xd9a019adf580e811, 0xf74907d9887f01ff, 0x4dcb217b565395c0,
+		0x0ff7442e0b45de48, 0xde18627fd4b86fdf, 0x92d45772943df3b8, 0x5bbeb58564cff953,
+		0xde245f4b97baef1e, 0xd8832639cdb08a46, 0x1b1f35b33f84da25, 0x5dd3205955197c32,
+		0xb9e394683eccbdc6, 0xfc99e794e5aaf597, 0x3885165f1e083578, 0x8027774d8ddb1f2a,
+		0x267c36a505d70b45, 0x07c455b5984d73af, 0xb5e434dae3a3684b, 0x63882853e80c8bf1,
+		0x8dc3afcf31ba40a5, 0x43a00e7a709b63a3, 0xe13eb25c93ea688e, 0xd7abfddf3cd0f620,
+		0x44d6d771a91921d1, 0x524c9c8b94a04640, 0x944f47099dc4002a, 0x52da86c612a19eab,
+		0xcf0a7540e40f26fa, 0x413371143383c45e, 0x16930fad7f509038, 0x655c70498e964e13,
+		0xc4552534b93d92ed, 0xf582d22bb5c1258e, 0x74ef3f243ae4c18a, 0x523e997904e07967,
+		0x539796f2cdfb49c8, 0x1b16017b78c83e07, 0x39d2ddf5f528eca8, 0xb05802e6824b7e91,
+		0xbc8a19f1b97227f2, 0xc42221337e74a387, 0x1290c767ff924129, 0xbc14a3b8d59f8db4,
+		0x790ee623fc67ff85, 0x5d93171e251c5903, 0xbc3dc43334e93593, 0xa362b04eb23fdcd0,
+		0x91980459a0e17cf6, 0xb564cac885134cee, 0x62f996781e8c1c94, 0xdf969a24625780a3,
+		0x9f5c6cc442c6b0d2, 0x629eb1144710556a, 0x4e528ab3ba71292d, 0x89e561291630913e,
+		0x0ad63aa939a22a74, 0x456dc59a924bffc7, 0x2c7cd65696f171e9, 0x010ee7074f8cb682,
+		0xaeb6710c14a37b5d, 0x58df1c6118f2f675, 0x9c8ce16a7b186026, 0x4968aaa6d7bc9b06,
+		0xfc9e1e55a3337daf, 0xa431a406f7f5d698, 0x3c08aa1754c423ca, 0xce65e7e9aee470d7,
+		0xd7766093fb34734a, 0xa9f3ca3cefa84cf1, 0xc14787c5473d7f90, 0x7f4ccb0e35d216ad,
+		0x5fb59754f566260d, 0xa4af834c75775cc2, 0x40829eeee408e769, 0x10ec98634e5af46c,
+		0xb0c3c4f862f3ffb2, 0x9379a8da1c667986, 0x14d5e7db6484d890, 0x36f8d4b676e5d048,
+		0xd80342ee70cdf5b6, 0xc96056d106c58375, 0x5c2151ffd732c12e, 0xdda7b250263d3475,
+		0x0899b4146d3114b0, 0x42c9f837cf27d899, 0x47632e17f789491f, 0x1143b9077051464d,
+		0xeff902c3d8acf457, 0xb01715ba0badbc59, 0x524c352019a079b2, 0x3b0b4122b573df3c,
+		0x08cd5082beda7106, 0x4b6c8074b696588f, 0x6a60a9f822baa724, 0x157b1c23b3ad4561,
+		0x1a85634e5e1ab203, 0x784d81cbffaf78e7, 0x90c31e367a2e1cc8, 0x44ce251969018a7a,
+		0x73acf81a6a7ebb81, 0xa01ed6ee62c038a2, 0xa6113145c55c112a, 0x8018f7165450c1b8,
+		0x759caa0317106f6d, 0x8ef6640d48923769, 0xbba9f5b9c08ae06d, 0xafa2bd58581391e8,
+		0xf576cad5724c5ab1, 0x21c2a3dfcba34765, 0x10e2337f8b1bee02, 0x8c8c31831a026875,
+		0x8be9fc51551c3410, 0x3faf7004caa35cee, 0x014d07bbeaeb3662, 0x1e467a560fec6797,
+		0x8ba1115f8c383097, 0x5e8c0e7d7c4473d2, 0x202ff22c7a10e23c, 0x5f294dacdb0e68dd,
+		0x974f9b077d291ef3, 0xedce9f7aadd23de4, 0x67577b45280adfbb, 0x895588f3df6ee66a,
+	},
+	{
+		0xe50cf80f98fe299c, 0x06288efac4a3cd32, 0x777651723a015d38, 0x0a3ee816950a42ba,
+		0xd049f672664bb179, 0x4651c6943f4deaa4, 0x0203191beb731c6a, 0x11367d150df075a0,
+		0x5418ed4c551c2b7c, 0x062a926fd8400f09, 0x7ab222beccea2223, 0x1b4dfd677cb2aa84,
+		0x47da6ae40d48c614, 0x6a825b19800e76f0, 0x9455a0c80addf641, 0x496000a211b8c37c,
+		0x0d75d3899959e88b, 0x7efbbedc16e7a600, 0xa51700e31c332efc, 0x0377b3b3670888a4,
+		0x24337d21301b3e1b, 0x3bc0f209cdac4be7, 0xa3eefdc22bb0d574, 0xd7e71ef017608b48,
+		0x75f947028932bc3e, 0x8a3e7cc59a9d7173, 0x567993ebd3219f65, 0x5c8fc5995acff15d,
+		0x30b06c9c063c93c1, 0x7492a719d217979a, 0x84b21a8d1c65af5a, 0xeb608aaa6e9d6d47,
+		0x2f0146925e854643, 0xf6ea43d94fbb9f96, 0x7c8feb5cb27b913f, 0x1ed3fe23c53734bd,
+		0x4560729789353891, 0x2e9b267b3a4a2044, 0xb2c5529d62e2805c, 0x17a5885ef69a4fcf,
+		0xe2f59449b6eaefbe, 0x8dfef1ac1a539d1f, 0x1faa7f58d09ed95d, 0x49626092ed72fab6,
+		0xc5774859879f4cbd, 0x003ef07841447c5c, 0xa65833d51ee84074, 0x29e08350ec009e32,
+		0xe5434c44009acf77, 0xc34ba8247fc85a10, 0x54d87e451883ea53, 0x721dea5c6c359fd0,
+		0x098224b369cc2a4f, 0x181779f3840f8bbc, 0x33b1168a5543ccca, 0x97e5c32cdcd06555,
+		0x3c5b6648d7eec85e, 0x7d928944fa094266, 0xb8cb9dc4bd5fce1b, 0xf1c86928f46798a0,
+		0xf6a0ae58b0c18228, 0xae523c60dbd09081, 0x899832b53f132d6d, 0x2c4893c09738768b,
+		0xcd031100442250c2, 0x53751f9e963cab29, 0x202947b8984ca7c1, 0x422648925b2b0612,
+		0xd1ab2893d412b681, 0xc4b773a8a3dd0647, 0x8ed836927e653487, 0xa326debab8da9908,
+		0x3acf4e3609c44c6f, 0x8c9690e61ffbb031, 0xc8ffb53a5c49a177, 0x74dc786de3e4f6c0,
+		0xff27283ee66ff548, 0xf1fe62d036dc8465, 0xb6ed1e7f7521ca40, 0x90287f7f5c04a234,
+		0xcddeb3d57356d869, 0xdc42a06f03d4b180, 0x65626f1a2f3abdbd, 0x7a35769d14216bf8,
+		0x388fd9f9144d0d97, 0x9dce9450c39c9f17, 0x4cae341170602085, 0xbb5d4a2c0b3d871f,
+		0xc644595d12f097f1, 0xf49a6a1f9b6ca655, 0xa894d53724e05813, 0xa511e5ef939ad6f6,
+		0x729c06c5edb84790, 0x8bac0c5c4cf4c9c0, 0x484ef08eab7f25b2, 0x03c400ed375315cd,
+		0xdb32b43682a9c5cf, 0x1c6a41c3a3c1ef8a, 0xdf0263773324fb10, 0x19d77f326dfa279d,
+		0xc7a8d471f4db3de3, 0xd1efcda84eb68773, 0x437aa73391969da1, 0xa01e1529404ca6d8,
+		0xbad082b679ea46af, 0x603e0e89ec728e62, 0x1a263a51d7a24534, 0x6668d82c526a1fc9,
+		0x3770111372074689, 0xa0edceb274ca3c08, 0x19ddc3a41abf2fae, 0x67c9a70105570abf,
+		0xd60245d2f1842326, 0xf471fd12beef5c8e, 0xf55fe756f9076a18, 0x498a25d6e534dfff,
+		0x33c325aa12a82ec1, 0x60ea8088072a01ba, 0x49c95b5d881a2229, 0xb29aff1051e50510,
+		0x7f3d7c579e8ada1c, 0x02cf12aa9de0d015, 0xe3c6e2dd206ad7e4, 0x675c517b5ffbe742,
+		0xcca95de7a77d218e, 0xed95c9c0f055ff19, 0x0d80b4d380711735, 0x0573f95b99b7e7bd,
+		0xd931563cb778f74f, 0x7cbb930a50db58eb, 0x8f2601df51c047b7, 0x8e0dccf41781ec41,
+		0x3eb69bb2d7d1a0f2, 0xb96217a5c59347ff, 0x3408ee0d7e8d9f59, 0x50308274b4c32d7b,
+		0x33ffbe3dda997f24, 0x3105ed6c8a363d99, 0x02c049392ab71bc5, 0xe9c529fef8ac260b,
+		0xced4f0994f998ce5, 0xf168daebbc15ffa3, 0x7e77611cf4a44abf, 0xd37cd4d1d6e36491,
+		0x0ce76acc4bf0c50a, 0x8d63ac9aa49686fb, 0xa7469d3ff7ee24db, 0xbeea3de00654c5c2,
+		0x5d452964982edd3f, 0xe40e322e56e8b554, 0x8018aef4f3005b67, 0xd00e03068959fa95,
+		0x67b8266eead17c7c, 0xd4231a01045dd40d, 0xb209b9e00882cb5d, 0xcc0c76980ca26a7c,
+		0xf60c2537f9e07345, 0x5fbdce18c7e9c246, 0xe75004eda7703b5b, 0x08d958e4bd437a06,
+		0x730362b4031a34c4, 0xe76a53411fce75f3, 0x2004d2c6398a7765, 0xec655c153e06e056,
+		0x6b69194f04db0431, 0xc25e2772eb6c8f5d, 0xcda461d28e28275d, 0xe52f6b6b1e84c3ac,
+		0xd3f3b38c1e05d651, 0xb29b4d20cf7c60d7, 0xff4bd0e26c523865, 0xf8ab94d1bab7f23c,
+		0x0e5293bee59725a8, 0x75cdcc7162cb1fd5, 0x78c03cd2f1fb6536, 0x3ccee3be9bd8f8e5,
+		0xf1fc85ebc3682c95, 0x76920a83bd86ec38, 0x70ad905a6eb6af14, 0x8ded73898bc9ee9d,
+		0x74ca59d2548b3110, 0xe9df0a628f4d5752, 0xd087729798a0841d, 0x07fcc722135261ec,
+		0x951bdc34296bc5e1, 0x629f41030b00c9b3, 0xde459ad411294e02, 0x3e862f28a76c0f39,
+		0x30ed5493061ee1a8, 0xe585fcb2658c8c61, 0x98438cf1dc29758e, 0x34e009d85155b7e3,
+		0xed7ce43d0af5e513, 0x07d771e8d7122df5, 0x800e5e4988e109ab, 0xa141875578722323,
+		0x001220c49c869f36, 0x6ce6095e04667b15, 0x7114c1c04a74ba34, 0xfe260af50c3791d1,
+		0x4abd310be1e6917e, 0xba6717d13dffe4b5, 0xbcefadb19f95e187, 0xc1da1459c25b98c9,
+		0x92ea12c39797095e, 0x5c0b5a737391e85a, 0x0443e866d2481802, 0x198aa60ab698b3e3,
+		0x34567a6d8dcb68d7, 0xe47b1226f03d8db9, 0xf358dad33fd12aa4, 0x76db55c839d3d9a4,
+		0xb8d185ff6372252a, 0x37688fc9657b5ed9, 0xbe1bcc68de4cf2fc, 0x83cb7b9015ae1de7,
+		0x47547db9942ac60c, 0x0e5eb7cec82fec75, 0x1709a04f7e7ebee1, 0xb6516a9323bf0f75,
+		0xbaab8a2baed0e28c, 0x14d3804407f29d3a, 0x0ee61eb7ccedd920, 0x01135baebb786312,
+		0x4d97b072fee82cdd, 0xe653d186894fbdc1, 0x416da9d6331f00bc, 0xf72c3ee75efd7d57,
+		0x86f25f27791008e6, 0x8774c247b89f517c, 0xd775d251da344b6d, 0xeddb00bac59676b8,
+		0x4aa81fcbc736bd9d, 0xe1ae1e32df3dfdec, 0x04f32eef895e65fc, 0x643b2eec0c492e21,
+		0x20481a4161a05c29, 0x9482371a90ca61e4, 0xcfd1d28f66e6af71, 0xe352f17b9032f7a5,
+		0x618d511588204fd8, 0xdc715a85bf717ce4, 0xc4632707f8190824, 0x5a8d974a9fa80724,
+		0x6e4f4ea1feab0e12, 0x240e0aa179ff666e, 0x4162e28010f42d32, 0xb661fd09fa018c25,
+		0x41c40b48ed41eb99, 0x8b7baff76e6a02ec, 0xd1bd13d18ebf4504, 0x73b4faf5c400b13b,
+		0x0ff17a0eb529b5a7, 0x55df220474a1c228, 0x4571b944c3f0e0a5, 0x9caf01843b927cea,
+		0x137323e6a06d4606, 0x11ea1e96df832b2d, 0xf1911b6382079a5b, 0xc8a46ab9c308a1dc,
+		0x9c2df6323e093959, 0xc789010443b646a4, 0xb2658db2518c53d2, 0x181e8236bab25eaa,
+		0x56d51479b66b958a, 0x5b57269cb2fca7df, 0xa74f801f30dd41c2, 0x1eeb2de1ebbd7b79,
+		0x8d07cb40987ea82a, 0x5a766b4faf01f982, 0x59a70c5a8ec3d491, 0x01cf4055d166c2ec,
+		0xd79bf3bf9779b23c, 0x14628114981ae0b0, 0x79e299149124131c, 0x36e51d0934f7bb1b,
+		0xe200d57fdab2e86c, 0x4a2ac122e93ad53a, 0x4e80429f8fda5fc6, 0x802f35156a0de28c,
+		0xf3dc919539cabf5c, 0x6adb3cebfab60b2c, 0x2158212c49224798, 0x330f1f7aaf7b7a33,
+		0x5be759ecf1cffe2c, 0x3078ff58e06f842c, 0x2301b1e8307e1859, 0x197cab3d7c8d9f38,
+		0x8b3eddb867526368, 0xa3b246c42b371e01, 0x5f3f7abc4b05ee52, 0x006aa57316bce60a,
+		0xa802d89d588fabc4, 0xfb34002c1e5aebff, 0x2ae85da36983efc2, 0x4c3d0320b4b4c452,
+		0x525bf2b230782898, 0x96031ad15a29693b, 0x33f642e7ff52abd6, 0xf38ccd25a25eb2ab,
+		0xdebb6f4ad0288b7c, 0x1f10d9bbec68c03c, 0x32320e50029984b3, 0x46fa9d8844b0e02e,
+		0x6077f070af59a833, 0x7cd97c460ce88ce9, 0xd573c812a4640957, 0x11010928857b3785,
+		0x9133c05fb336e404, 0x0153164038547701, 0x94f59eba1d65372e, 0x375b4c71eeb6e6fb,
+		0x363f73988f90c0a5, 0x1452a747c9cb3c13, 0x40af6deb025e2619, 0x3d2bdc81902a15e3,
+		0x4c9c823d65bfef04, 0x1a6f6683b59778c9, 0x16290507e5ad9e34, 0xb3caf3794a8666ac,
+		0x3e777c012d633e53, 0xc74933cc63ddbb73, 0x37060b65958cc2fa, 0x01d163571b18098c,
+		0xce5830c336ce5842, 0x6d7f29571ac14870, 0x70470145b5eb987e, 0xdd019d42d167337f,
+		0x89f7f513f519dc83, 0x0b2851c2728ab5c2, 0x29a59c46e67deb76, 0xc656b2d0488d88b4,
+		0x9bdf05246fb7fe65, 0xf556539be737a95e, 0xb10f47ea5876f498, 0x064b2053ab35961c,
+		0xa74c0b20ec4fbe60, 0x7e2d75106934b370, 0x7f632c52d76c03e9, 0x99b632d80f4013cd,
+		0xe9c30c7087cdfbb2, 0x95cc90eebf473e32, 0xb5c1b76b4933f8c3, 0x2948c62c2ea8c6b8,
+		0xb60dbbc49e6eabf8, 0x5dc29595c5e1ac1a, 0xd0f3822cb15ba41b, 0x1a4e28bc31a11d58,
+		0x082a5caaf544d206, 0xbb8b947bdffd1397, 0xb11e504c57c9a729, 0xfc3a1ef1ab1e0d4e,
+		0x9a21d4f8c8f38f0b, 0x204ff7dbf852360c, 0x05135001027e234f, 0x91598fbcfbfc6aea,
+		0xb0b42ddab1ff4a56, 0x65b9a01140fb0284, 0x3a33c3c13188cfad, 0xfd61694fca5908fa,
+		0x737df39b40697200, 0x1a66b9c877b4dbef, 0x3791b08365f12c5e, 0x14b082ca865f0789,
+		0x234bd8def6648b61, 0x8874f97ead139d99, 0xd0f951979846fa55, 0xceabf50db082d4f8,
+		0xb4babbbd6a873ea1, 0x175b632f93ac1aad, 0x9bfdc646c8f00c20, 0xa8089b99bfd6569d,
+		0x4b77410fbf6c7463, 0xff543be5ed0b28de, 0xe7567c1db8b3426d, 0xb5a76a994c0e62bc,
+		0xe7028815fbd384c2, 0xd71dc004f3f5c864, 0x4400c6103f7345af, 0xecdf2b89b8a704d3,
+		0xe6a669fe23693ad9, 0x95d41955e4823b19, 0xafad64fc2d5bbadc, 0xab2faadd2dd2217b,
+		0x00f7b0c222b1a3ac, 0xbd6052220234021a, 0x5a1885f564e46c18, 0x3e6720737e23c70d,
+		0x0fec4a5072b29d09, 0x5b0fcb5885096714, 0xd890ae13278c96c9, 0xcf04f8f080f6eab5,
+		0xbce0786d2ea852b0, 0xdfc925633b5ab927, 0x8cf86722eb09b340, 0xee1d42632463eaa5,
+		0xcc378dd1e83909da, 0x98f562a161f42f74, 0xd129cdce29dc573d, 0x97542d21abbbe1d9,
+		0x18a3952446a1cda2, 0x07f2c5bd1c3c1669, 0x963a081359b655b2, 0xb615bacbe9827911,
+		0xa6fb544bc8f1e047, 0x26174be6dadeeb5e, 0xd6789b4b723f360c, 0x4176a183d26b509c,
+		0xb17d3854d9b8fbc0, 0x3817d29ce6f869e9, 0xe5df3a6efacaf995, 0x587d11a474ef9236,
+		0xfb990555160c7674, 0xa589dc1ad9ccf156, 0x5b0eb4cb3d8fd357, 0x98e12bfefc323864,
+		0x0756af5e3d021e80, 0x3bc7f0def962cfd4, 0x50dfc0f3ed9795ff, 0x3c14c574f00e1bca,
+		0x24844f45c2dbb14d, 0x1fa7646ff7da1057, 0x961ecdcbd6a2821c, 0x73f169b462f13153,
+		0xce881756b03b9037, 0x0c7e8f7fb0ed4479, 0x7c599160d38483a2, 0x71087d19172f0a89,
+		0xc085b7013be1a369, 0x8f28f62d1441e30a, 0xe5aac8932a0463c5, 0x677462df74de33ad,
+		0x029c6f097d5d2d85, 0xc296f0c952ea6718, 0x9e811f671c9058f0, 0xf9909b25d7898939,
+		0x8cfffb5200b952bb, 0xd4d842dc976b7dce, 0x06f9ca680354510f, 0x41bfef15c29bf495,
+		0xd51163ed52f462ec, 0x53261966e9689bcf, 0x02e8ea740e50452a, 0xdc776070da4d0996,
+		0xa18bfaa8513ce0d4, 0x2ce51a0dff79f725, 0xdb5c51b9fec5b4df, 0x3d1ec236ef280a0e,
+		0xb4946acfd1d73aae, 0x70d9496b8c6fa36f, 0xf8a4e16a51dee359, 0xe7e6565d785d6e56,
+		0xead27fbec8ce7bdf, 0x0e446d3ebc981297, 0xa6849472b55b0d15, 0x9facb2a98fe9c671,
+		0x9c29b3cbabec8f30, 0xfb4d84ad1ae89813, 0xb9580c99937f6060, 0xb4dbb70704cc0ed1,
+		0x54e4feeac23b4c65, 0x79fa0cd56ae1acfd, 0x7d823bb4ac20d713, 0x9c30e49226f37408,
+		0xbcc5598014889906, 0xf42487c71d88ea43, 0x3e37251b266d82f5, 0x7cb16caecf99307a,
+		0x6963b973e18411a3, 0xd1d0f15f228eca70, 0x35bf614aa055769e, 0x71e8e91195f14af9,
+		0x87a5e1b5a1728fb8, 0xee28aff0e4d9e632, 0x4d2e0bd330b3fc00, 0x8269d8836000971f,
+		0x32d22a13d474b9e0, 0x4d3414b4b3d6d6f4, 0x13af5e559a3e8c2a, 0x7638c5f8922a5351,
+		0x3d1abd663ca66e19, 0x94612fad5d5fe4fc, 0x02599968fcf84c1e, 0x06844291537095f8,
+		0x4b1a76ab32c45d7d, 0x39255e7e7ca5bfbe, 0x75da68e31bff2ff7, 0x165bd5bd858e377e,
+		0x31bacfa01cb6e564, 0x9bff3cb1da252824, 0x6c61f613be85b9ff, 0x9e7355111cf464c7,
+		0x79c988949815fffe, 0x0685e1aab751d2f6, 0x61b23b7a65193107, 0xfe1bf21b546dbf8d,
+		0xdf7680755743ae0a, 0x914cd2ce0593f3da, 0x96f194be073109a0, 0x20668341b6caddc0,
+		0x0d1a8f26d2191e4f, 0x0588d7756e196310, 0xa0978073297beb1d, 0xea8dce2db939b1fd,
+		0x2793ee65d3445d97, 0x66c6faaca0ba617d, 0x8bef66c9329a2a0a, 0x0e7c1b70b64172d0,
+		0xefeafe3b22ca6fea, 0x1318ac2218dc6921, 0x8cfb36c33b734842, 0x082a3eae9d2f757e,
+		0x000c934419c62c56, 0x278fee2028b58256, 0xee18b021e53c2e8d, 0x015c36185e74432c,
+		0x7c2fbb018da2b4ae, 0xfcd24e67c2793081, 0x87ee1c41ca9591ed, 0xb59d74613bfcf5c5,
+		0xea6dfd41454f625c, 0x035536fce279a897, 0x2c77c2807f22ebde, 0x085f42cb17dcbc92,
+		0xe67c73eb3f28784c, 0x7bff595c1a6854f0, 0xe9b1bedc4fbf29ac, 0x0d8edcb698014916,
+		0xa6f64538f6fd61df, 0xbcab71203d0a5626, 0x81c7bd5ed373e331, 0x903a5d55303993ec,
+		0xa70014b896c245ad, 0xf472acd0f67038c7, 0xc51314936d28a062, 0xa6fc9e00e42b9fb9,
+		0xbf0583c69fdb6780, 0x0829e3fa7059e05f, 0x5f7a67306c145bfa, 0xbfab953bcd285fd2,
+		0x16eb900dc92a613f, 0x85ba3154ff21382d, 0x66664a2610af3523, 0x4f3a4b4c884f84d6,
+		0xf2d7de147b3bf0c5, 0x86c710e922209471, 0xb7fad879a4fd2f46, 0x867d7dc178e58b7a,
+		0xecfcc9f7a39fae7f, 0xb0204ff0ccc4797b, 0x90bcf4e2321659c1, 0xfb722fe2d7f08e0a,
+		0x8c19a89d7c8e6c9e, 0x00b31e3166b9a9bb, 0xded05776ee7d19e0, 0x4c70f5f14b8542fd,
+		0x1363779a3d5cae25, 0xe1c5151345c13382, 0x49eb9b203a024314, 0x6e2f229455d9e95a,
+		0x9c72696691b58e1a, 0xbbc24e6f607e2c19, 0x30450375ee488460, 0x834d6bd5c6c31d64,
+		0xaf1d54b52e3b0360, 0x15a313780af2c07b, 0xb481c4bd33715b3c, 0x07ce9eac4ff0360a,
+		0x7c25b286662e019b, 0x6e8032c0664dfb46, 0x93beec752628c8b2, 0x3a5676115929af51,
+		0x2d91b545c0b94aca, 0x5b9f8b481ad98f8e, 0xaa2750c84e392a8c, 0xb88bd23b2af86e2c,
+		0x9233f4f36f512317, 0x66ce185caeda9033, 0xfcbc99e12b2fec61, 0x7f2adb8b4933e0e2,
+		0xdb3e52e4f9c9fe32, 0x176229f8698fda26, 0x1a1aa564275f7ba7, 0x1a135ea6ab4b4732,
+		0x67577919f5271258, 0xf2fe6743d9293042, 0xcc2bbb688452d749, 0xf562cc962de5ca5f,
+		0x4fdc955b9f265cd8, 0xa4e3ccf68b132fd3, 0xf6afab404668a1b1, 0x1e1228382e4c2d38,
+		0xc84bb1095bfc0a07, 0x935b29cc73018469, 0xeeac3e94ae4561bb, 0x5157dcc6bb783778,
+		0x89846c4c5e97094e, 0x8c09b3b90d02bbfe, 0x786d067fa15a4ee9, 0x68929853d1306260,
+		0xed369851c98d6e63, 0x4b5592d566a96842, 0xd1f94d6fff1f0295, 0xe0ea37e7589f7566,
+		0x77fcc324b5335c6e, 0x13442390115ef312, 0xe4270c544735bdea, 0xf4078f38810a359a,
+		0xbcf09fe4cd234115, 0x6fc8dbb4f217f4b9, 0x3f427a3443892699, 0xd1fb7426f56bf855,
+		0x3a539b1d7da7fe79, 0x2638c8866e46bbf8, 0xb6627e6d4ef52783, 0xb090a6f43e8f12eb,
+		0xbb4a4ffea3e79e7e, 0xbd2178b5224fcea8, 0xee3c8b216e4ae2e2, 0xd9e1d8f0e4fb6593,
+		0x96c44d4656b345cc, 0xe356294be3fd9e09, 0x8fb880ba4d386e22, 0xd73b3d601e9cd790,
+		0xb4c3e26368c5f8ea, 0x5f80f3e7b2b89271, 0x7fe3cc8e8375ebcd, 0x5066bf9cbfdd5590,
+		0x2c26a588fffa48ce, 0x17e4a9900c9c8ba2, 0xadaff31253b3b17b, 0x6c39b1b9cddbea5b,
+		0xb4d2b80e1206b04a, 0x38f628fd18f6e1b2, 0xae81f8b436ab061a, 0x1888342e81096110,
+		0x1fd07dd90f4c490e, 0x3db2464be1ecd263, 0xde2b157ecf66c362, 0x1b232fa0b252885f,
+		0x95e89d2053653729, 0x2af6dbd98dc256e0, 0x8c92a48e837cdde2, 0xdb1cc875b4a4c1ba,
+		0x618f849a36f5ff14, 0xb72e97298ae575fb, 0xf9c563c50c7c7775, 0xebf29fd76eeb441e,
+		0x4442118d72b4ebe3, 0x466de5fd951ecec3, 0x0136ed8a28ed67ed, 0xb8fc8d6a0e7f952b,
+		0x975b0028287b9586, 0x29bcc38d00a1d509, 0x1d87b38a4a7be74e, 0x2e0a52dff719be67,
+		0x4e3ffc364e69aac9, 0x27938ae3ffd144f3, 0x49947ee3a4f04bfa, 0xeaeb4abc3821e7b8,
+		0x1eb945145a0f19a8, 0x3abcbb4b22512317, 0x5e85f645e7a0479c, 0xbfadfe6e1f03d23c,
+		0x98787f6502aba66b, 0xc0bfbf3fe7d6c6d7, 0x1a96b1309fa5f7e7, 0x3d3b3ca3d568dd6c,
+		0x1b5d9e14c295ac34, 0xa1e72b0bad487ffc, 0x12f8af6692b1eff0, 0x447c42df1629854f,
+		0x39112566d4378ff8, 0x447fbdf26d442486, 0xbaef4e3e638e524f, 0x5530e8c51f0fcc53,
+		0x7eeb8d4d4b3a5682, 0x89e79e94b18e328b, 0xbaacd9fbaffc2925, 0x3054750d46e1324c,
+		0x75651a56836d1e5a, 0x22ce8b9cd1057d3a, 0xfac3c996a45ec671, 0xa71797cacdae069b,
+		0x31e4e440d236e20c, 0x1276acf656208604, 0x6057dbb24137e2c9, 0x3ccad1f8305edeab,
+		0x8a9251bca98bd745, 0xb7f49a8bd98e03fb, 0xdbb891897f015aff, 0x623a95466ee81202,
+		0xa12f7cabdf90fa64, 0x6c785e6ccbe4e39d, 0x87101a7c742f2952, 0x4b595d780844c56b,
+		0x72f79e12c66b6602, 0x577f36efb256445b, 0x506bdc7661483501, 0xd97804ec9fb7fc34,
+		0x9bfe760afa9510ce, 0x7611a0c1302bf518, 0xfb2931660857e265, 0xbf490aa2da6db1b1,
+		0x363cf346930e18dc, 0x71ce148c2cc3aaf4, 0x3694809852d272b0, 0xb0af1ae9d40260c9,
+		0xd9bd82b8e199deeb, 0x9216fa18b096447c, 0x12862981895d47ee, 0x4e93a35580472cea,
+		0x9f24b69de811414c, 0x5fc6a9b43e7f320b, 0x3529537fe2e69d84, 0x12f2a37d6171af47,
+		0xca15c5175b3df105, 0xcd3939cff26075eb, 0xc3b8de793e61d800, 0xa0d47c930ea9160b,
+		0x9f30d983b9afdc18, 0x1eab7b819c45175a, 0xb2c8a82580e4092a, 0x64fd744bf0cde41f,
+		0x9cc5a45c2eb8df06, 0xa46b26c989142489, 0x97b3f286e8fabb30, 0x8ef0af4731e1740d,
+		0x2f27c884a00be97e, 0x39bb532da5ab18b5, 0x501dc0261c889287, 0x0b10819e0411ba3d,
+		0xf2976478b26ec2b0, 0x4e45356f3eaa480e, 0xf538146a4aa66b57, 0x114efbe5b1515fa7,
+		0xb4ea92652ba39bd0, 0x978b520191bd815d, 0x3115963143156dda, 0xb162a9803ae6fa58,
+		0xa7029a03971b5a5b, 0x898de6ac032e913f, 0x9d5bfb3b83035c5a, 0xa48868652832ff66,
+		0xf14c5ea8ef49534a, 0x9a9e9bdd966ac3f5, 0xdea5c9ca128e8018, 0x924555f8a2c0e7c0,
+		0x1fab0c3df143c4c2, 0x10a56a03d7e92fd9, 0xefecefa331408253, 0x44fafc5d48715595,
+		0x2e94c13d6a580594, 0x30d20f7dc7da8389, 0xdead8721e1e38ea8, 0xd40b54efa60b45c4,
+		0x3c577a2fc9660937, 0x7d77279a7086c021, 0x8e0910bbb7c47d5b, 0x14366c75ca2d6816,
+		0xf74c5b9262a2b522, 0x50c264ab5e1e884d, 0x58a787e98a6dd0aa, 0xfeb430cb4d0e917f,
+		0xe766f0d3a7fd824f, 0xe726ad9a288c689d, 0xedca7308db3df98b, 0xa467bc17c4ef166d,
+		0x2828ccd53065d26b, 0x6d55b41aa25c20c6, 0x2b0b8099d916e037, 0x3ab7331a4dbe7e2a,
+		0x10882cca6d04e5bf, 0x8381f08643d545e4, 0xbba0090d1365524d, 0xfa9388b5f3e88a39,
+		0xab310e77e25980ef, 0xf63bf32555c570b0, 0xf9541cb447e57ebc, 0x55e81d102ba8648d,
+		0xbd737a6b01fd8dd4, 0xd76a9d73f01db627, 0x07a35bdd68c51051, 0x71a4d8ec99d8bb87,
+		0x0051336c92bf668d, 0x685f60fe9c3ddad5, 0x3cc9acee265f8ba6, 0x6ea9252070ac8ba0,
+		0x64c08b24345b3cfd, 0xf18c01ab9e233177, 0x0b0dddb805c7d87d, 0x65e11fa938462fba,
+		0x6546977aa82aeac4, 0x108826044414d8c3, 0x6b4d4c2740a781d4, 0x91349f13aa282c36,
+		0xd2e3678616bba6a8, 0x133a724fbb277d89, 0xd868629b03d35f98, 0x73150ee4706b7255,
+		0x532c7f7b2c1052ee, 0xb201363c5d9baed2, 0x6b6b8cc5cb49c59b, 0xe1c934dbec28edc5,
+		0x9a1f9c083d068265, 0x829f223078814053, 0x8fb3f81880138cb4, 0x219948e6e13bec2e,
+		0xb683b63778b032ce, 0xe58131002ce0559e, 0x8e52ad98eceb1282, 0x2d616c14b4ad545d,
+		0xad19aeb165aa1559, 0xb3114314a2fdec21, 0x360d6649ede9dd10, 0x75e77eeb68d1470a,
+		0xf97e73aad98d56f1, 0x83e888b59cc1a890, 0x9457732ca3b6ea37, 0xd03bd9ca9e4e6b08,
+		0x20438ae818447cbc, 0xc1551f3924040c8e, 0x05438f6078191678, 0xc394ba38a6226b5a,
+		0x47e567c545a13ba8, 0x37b12a3f6ecf1cc0, 0x9822923b0ff439e3, 0x0563ca5b51f495d2,
+		0xc675fbf63a7fd00b, 0x4133e74e638fe7a5, 0x17f53e36e3be185f, 0x91ffa1214bf70610,
+		0x0afee75e2dceb1d6, 0x72ae52098b9b4eea, 0x653fb548bf35f722, 0x10d4f7c792a8cd2a,
+		0x94e40ac3d663f9cb, 0x26d4087038a906a5, 0x4d81f4c7ca60d799, 0xd94d5fe0c020d502,
+		0x625cdbfe42d3f33a, 0x1d95099f10aef336, 0x1ef7c3f697a921fc, 0xb6c3d37c2726b365,
+		0x02b0bdbceddf3511, 0x15dab3a491b6e02e, 0x088280db72c8b949, 0x6cc5d248395b388c,
+		0x9c536890fda47945, 0xbb3abb94b469a689, 0x90149726b63a124d, 0x99af0a63c7a2c8cf,
+		0x9a6d36120f2c5a7a, 0x562ac6e1b5e26d74, 0xdc7c65958e9c419e, 0x3fe9819e98ffc8bf,
+		0x810125dfcfaeb7df, 0xed63a7690c66f01b, 0xa15663ea93e12d0c, 0x3f3274a3cc793c21,
+		0x98a8735419a28b08, 0x06e0428d6dd35860, 0x87c8900855b4197d, 0x87d86ecaaa6c7167,
+		0xf95d9923bff4be5c, 0xf6c78e2214fdd203, 0x9880b5ed51a277b3, 0xfc50a45b8a38b2dd,
+		0xf3396a044bad7723, 0x0d2f8f122a041df0, 0x84ba2621060e8b0e, 0x78c2514a9ed1dd4f,
+		0xb2bcef86b3b54e86, 0x7bf517b2e3bd03e6, 0x3a08024e94e1785f, 0x230f1e2a7afa41d1,
+		0x1e6a670f53502d69, 0x5bf095268fcba2ab, 0xd4946315823acedb, 0x1278d7fe642a3e6e,
+		0xa178f8522826efd6, 0x8e50d164de8ebdf1, 0xcdf6e9fa1d9d6147, 0x0b60931bcc79c0ec,
+		0xfe74c7cd74909f4a, 0x9610ff8ad102abac, 0x89fdd9b8889d78ac, 0x97689c3ea20f7b6e,
+		0x4380c33f4a337df0, 0x588c684da50dccae, 0xd22e305cb72d9258, 0x8fc6ba9c9415866d,
+		0x5aed4039d9bc1341, 0xcf7f96a2093841d2, 0x01d8c7993bfda955, 0xdc1a26a56d86fea1,
+		0x2d00a2301f3e8737, 0xa5138ca6717e50c3, 0xfa2bb996a4c8f2ec, 0x4f20aeefef1f9c59,
+		0x00de59362c4a7a8e, 0x979210f8355f1c1a, 0x3338bd3fb289c44b, 0x4342fa4f5e176cd8,
+		0xe975c957fbefaaaf, 0x17360ab874394b80, 0xc472aeefe7093f88, 0x29a0a255a4e8a1fd,
+		0x74c86bacf6af071b, 0xefd3f6f4aa847f01, 0x9926c156c76028e4, 0xd9b5bb887040aa15,
+		0x6da3f0cb45c815df, 0x357aaed27c6fbe1e, 0x281cdc2ff7b052a0, 0xda24cd93f7b38f52,
+		0x9d5da53c9b5c0443, 0xd624e30bda16b107, 0x8becc2c649209f1b, 0x5e16ad3930e6c394,
+		0x0e778dc69d1bda53, 0x8148ee46f340392f, 0x36ff82f8c274f06b, 0xc33e44cf36355c60,
+		0xd5de4d5b4237c590, 0xb83c221d08f91db1, 0x07c8ef66612d0f51, 0x5902790cd65fe5aa,
+		0xc4064d85848b6a97, 0x958ab78648d8fbd1, 0x520f2e2e5ac460be, 0xa48f027abe245dc7,
+		0x06367b1198635a80, 0x3a99e53b1c0ce3d1, 0x65910b7eba3ca869, 0xf72740bcb4c2d11a,
+		0x3ce60ca4d1ceb962, 0xd1982e2be674b521, 0x37c72bf6937063a4, 0x3056a38c17e8beb6,
+		0xae887e33d1c85a81, 0x59a8da03f6964840, 0x6602c6032c306a5e, 0x4f2db0a947910371,
+		0xa1bae4636179c83a, 0xbe80d568a597bd9e, 0xd7c35e873df719b3, 0xfc1cf065c71b98e1,
+		0xcec3d3fab79319d6, 0x34a620f77108fb72, 0x4b666414469c8787, 0x5e901013af506541,
+		0xe216d590c4f33b48, 0xd7785f45ad33e610, 0xdd4507fe16da3fad, 0x59a08ae531c40d96,
+		0x603ce9f8e77207b5, 0xc55b269802932f11, 0x92214aba8352dbb4, 0x82694349d683ad57,
+		0xdaafa63e97aedf41, 0xcc6d826038db4e0d, 0xd19a2fe9f31eb8e6, 0x4dc1ba42c895e85c,
+		0xbdf7f3af31b5400e, 0xcf8255032b0de55b, 0xfd22b31f89ed5839, 0x52a5b4fd6c91a825,
+		0x17bd060f661b8e47, 0x6bf26175f35d7025, 0x1555b1639b6690e5, 0x528c27f91bd7d158,
+		0xd071070f13da15c2, 0x00cac7708439aa96, 0x341a68f29fc6426b, 0xfdc480a4fbaeeb90,
+		0xf1bc22d9775d47eb, 0x954dceffe0da274f, 0x50fbdde3fb1ccf10, 0x5685a8a44815a4f9,
+		0xed65da889ad98460, 0x63777b1d1f505a1e, 0xb9e90bf131f8e254, 0x340ad34a767d0181,
+		0xc5a3b9476bcb5f3e, 0x0f7172db4acb9fe4, 0x4c3ebbb766d9d492, 0xb0cd05013f8a8e46,
+		0x52963593c1ca7d1d, 0xd7beefded8a03668, 0xa0924f04b77f4950, 0xa7b10b036a2fd132,
+		0xb7335ab9ee5a708a, 0xadf8eb4e744daaff, 0xeefdec2dcc67268d, 0xab4daa24f421a8c1,
+		0x55de500405be610f, 0x09baa5e73dabbfbf, 0xbc56332e5bc0d23d, 0x88b66c7ef9a5e786,
+		0x733148e0b105239f, 0x1e3364deab72cfe7, 0xba15b89ab6736a79, 0x0ca8cbaaaa023ce8,
+		0xb2c7b2efff44ce81, 0x173960f708cb75f9, 0xed409248f01934a6, 0x01a7957b1606d7d5,
+		0x0ea27c2e2a102cb5, 0x85985d952a9b64ad, 0x9238f4f5178663f6, 0xd7316e61fef4047b,
+		0xfcf04f5a791c3344, 0xeaff6cf40a28971e, 0x1adf9655242f02d1, 0xa60252cff6f77aa4,
+		0x3f28001e5dee6584, 0x833e490d43e5db43, 0x17a14e83dce7c1a9, 0xe14672642954fdbc,
+		0x13c4eac150bcc394, 0x7991471743bf3b77, 0x244c520ebdcdb266, 0x0cfa9b7deed10395,
+	},
+	{
+		0x86105bc1d76d3c50, 0xf8848cb573c72c8a, 0xd7da16033a9c2c2e, 0x80fe87089d7ec4ef,
+		0x6e464698010fbf22, 0xe0ec9a2720e7b829, 0xfe627651c0d098c0, 0xa3da365012e8eb07,
+		0x4c9d15fa66d4d865, 0x341cc4379f492bde, 0x58bad2cf0f8321a1, 0xcefcc638f1c39fae,
+		0x0cf719d583a07dfb, 0xabf09d15437f1e58, 0x438b46e669d6d8c8, 0xb3d10c698610c062,
+		0xb3aa717d4b7ecb88, 0x9a69ae1c2b43e12e, 0x01f64ca8ee896d84, 0x01ba382d8c66705b,
+		0xa8834480d7351e8a, 0x95dc7ba9f37e0bdb, 0xd341c40936839017, 0xd4108af54278fa47,
+		0x9453c0a70ef6bf33, 0x384419843e1a7263, 0x04d183f58f4d7138, 0xe39953abb37be087,
+		0x3550890bfb70c668, 0x6b0a79bb40b1db2c, 0xb70e14c96921ad3c, 0xc33788b8510c6515,
+		0x469fb95987668a9a, 0x4f871cbf1e917f3e, 0x647dc2be752276b6, 0xac9edc0e90105274,
+		0xced9be9c569bbd01, 0xc89df6d7395f36aa, 0x45d5a7c65097cbd2, 0x5880e7e83a9ed39d,
+		0x8a978bbd53e17c6a, 0xed0dc658307405a2, 0x5b257dcd47a6a993, 0x237f4ff0405a516e,
+		0xd37dc82d9e544620, 0x41adf6f406d37293, 0xbc82e5846b3785ba, 0x1ff41b3a10d1b1eb,
+		0x66c51ccd0e25d500, 0xfff569f691cf9dc8, 0x8763ad2320c46f5a, 0xde87ad7bff925267,
+		0xde1f71aac94e92c4, 0x11ed40cc430dd259, 0x2bb76b9017495d7d, 0x2e1f082fa97c08ae,
+		0x6de565e19a0d0028, 0xab37b2df81435009, 0x92c1e848d758fbc0, 0x150d135af917b013,
+		0xf191ab447cc5e087, 0xd467692bcc3eb023, 0xb4b08d8a8733b43b, 0x400c431356ef1ea3,
+		0xa38c66bc276069e6, 0xeac9a88cec69aaf0, 0x7a65912f4518f6a2, 0xa3579138e928149f,
+		0xe248b9922f460a76, 0x05fe1a095f6d4eab, 0xf846ed2a01e7003e, 0x1223f88ea22c81c6,
+		0x915b28f4d007ca88, 0xbb6462c2178fbd2e, 0xf243d6cf9c91d7be, 0xf3c446ed52df375c,
+		0x24565e60c0f1c53c, 0xbaca5b0a7b2437af, 0x24d0ab364d98e1d2, 0x72efced5fd4c7c57,
+		0x61e3afe49b09c600, 0xb50f00aaea188a50, 0x5278ac810ebee35e, 0x8ecfd67ef6e59080,
+		0xb47960aa09e63192, 0x653c3b5b69fc2475, 0x081253a8a231ff11, 0x113936d33be02b4c,
+		0x0bd94df1783973d7, 0x8ca388cb0368e317, 0xb1ae05b648bb9fcd, 0xa578f92ab42cc450,
+		0xce6075f4cf3b2ef2, 0xccecc5d910abb4f9, 0x9d02bcd1ca82f698, 0x16f09fe052afa4b2,
+		0x2c25025e38b2a538, 0x713d128f65b75c0c, 0x2c901494e94333de, 0x7561980f164336e0,
+		0x812c22a69ac24508, 0xd9363931fc65d5a1, 0x496537492f5b03a8, 0x154dd1f3e31d5e8e,
+		0xd0197e661cdd5074, 0x11705c2f15392442, 0xd1def54206100d38, 0x52ab32829dc35e65,
+		0x1ea682123d8a9bbf, 0x30c5768cd5675af6, 0xa7a1e5c5bfc8c8a2, 0xc4094551d61dc956,
+		0x05b5ae77c54c0a2b, 0x5573cdc17e0d2083, 0xf317b41e63d6f3f4, 0x96752ddc1c7edc26,
+		0xe32a2548907a7423, 0x78f2bb3b2fe183a6, 0xa63ed130e41fc974, 0x8a39980f23fda5d9,
+		0x8a9929c6a1e48bdf, 0x550a263923a486c7, 0x811114b05a794020, 0x1c05e9875ef2f4e2,
+		0xecb5247a76c64d51, 0x676eedc8ba8c5b3a, 0x64a940a64b281021, 0x134ad647e5b0a900,
+		0xa71e155c11135619, 0xc8ef12ea518f712e, 0x4260bc7710a5c238, 0x76bb115a388e37e3,
+		0xcf1c5ebf81119c3a, 0x1e23bfd7ebdaaa42, 0x7f8750aa4684f69f, 0x108ecd1d76c34344,
+		0x9afec6b52768f871, 0x53eb65d3fd1c2ecf, 0xf7025bd96f769dcf, 0xe62432deb7272b35,
+		0x8879026d789daa7c, 0x717b6e0cb04ad380, 0x147d1ccfea287f62, 0xb34dee49b61a3776,
+		0xbe24b8f282cf9b97, 0xc72b8810b7e580a4, 0xc18366e6ea65ccf6, 0xcf1ce45a6c45abdc,
+		0xa429b38d7b60f274, 0x2b11ee897422ad73, 0x7b0ac2c601bc2541, 0x288a807ae53752d4,
+		0x28119c471cde154b, 0xd6bd43819e1af2da, 0x1733340346c29d79, 0xa0123b89f8b12bb5,
+		0xd4ea4bec4a8ef454, 0x9e7fcdf8667c6c0f, 0x59ccf7fc25fee43d, 0x8640f8a9abe6ee17,
+		0xa7d88e6d89b155c5, 0x57e12c83022f0ee9, 0x0fe1ae172ca659ad, 0xb220ee6df19c8e4f,
+		0x8700ea2f10258c60, 0x64422e9473acebdd, 0xd0242a8c1b40a29d, 0x67ec2cba9287dda2,
+		0x1e5f2ddd5373e750, 0x5fbbf1d70f5ca313, 0x7c1862f346127618, 0xfdc7081e60ad8dbf,
+		0x8d57203cd0c40cfc, 0x6c151c4daad69613, 0xea9b3ac92808d136, 0x8db362d522a9f7e8,
+		0x91fe8c3900419a7a, 0xf53780e805131fcd, 0xc1469399057730ee, 0x79c4fda0fc44fc8e,
+		0x42e8ff06ad95221f, 0x6fd24d267411b543, 0x692e7abcc1a7a65a, 0xe7fe6d495873e310,
+		0xa3326ddf7b305ebc, 0xc7a098668c642f99, 0xc2201af591ed57cf, 0xfc1c62fdb42d42eb,
+		0xe142319e526c0b00, 0x49143e88ce50b465, 0x8350b743f91c8f05, 0xb5a220a870bfe9b4,
+		0x85c1ee4ca441fbc4, 0x016581df2105dece, 0xc4dfe57f2c56f2f0, 0xc28ced7276e9d407,
+		0x8559939043ee0fd7, 0x141773f0947ec665, 0x97146a5eb2ee05a0, 0x5cba94b7fb6113e9,
+		0x6409a084c789dda7, 0x05fb0dde2c5b3617, 0xf4f8bb72119413c8, 0x1a996a1cec9d4a94,
+		0x2514632768eb10d8, 0xf476851d5c6abfe6, 0x089b60ad1a893b6a, 0x344db9c026e119ed,
+		0xcd4a768e28110b28, 0x559b810fe4dfb6bb, 0x90baf88a9eeb2fc1, 0x50ba80c47a2ebc04,
+		0x8b160a442792d50a, 0xacee8f8cc206e6fe, 0xb1afb4b5d49aa1ec, 0x8f73d5ed32e05adf,
+		0x54b4e07627b3e914, 0xc00b54f9025b6f10, 0xb3fd87025d181997, 0x4c8f722c94a04461,
+		0x611f11f514bb2a49, 0x997e8de9df4903ea, 0x2b2c7f240b02410c, 0x4559f3c335547480,
+		0x200bba9195cc0260, 0x5277d88fb3f7ad89, 0x6ec652f8983a3ba0, 0x1d3d64d7ce2fad46,
+		0x32883dbd0bc13749, 0xfd451a505f5e11ac, 0x7024dd756482dd15, 0x93c033786a2068ff,
+		0x776c05d3676ca94f, 0x78a8588357da6910, 0x7cebedc5efe9b9d5, 0xbf01efeafdc0ce61,
+		0x846796f4005f8133, 0xe3779b628ba27ae5, 0x808368fb7c4117e8, 0x582cc20704ed693b,
+		0x532b02d5054774e6, 0x1fb8c57ff455ebf8, 0x41718652d1ef7fb7, 0x192faaa2ab51de6d,
+		0x509a7feadaea1e6b, 0xdd4ac861a1cdb722, 0x298e159149595aec, 0x9309ac8533873729,
+		0xcb88aad0da9ecef8, 0x9b1f228394ca45ac, 0x9bb5cb0554926ae6, 0xe3b50e9b25d9d7bb,
+		0x0f56155f0db89d9b, 0x3bd34040522dd254, 0x893f87985a5963b5, 0xe08101cf9bb872f7,
+		0x5297aee1b33795ae, 0x7195187701f1803f, 0xb747125aa62c6221, 0x4d51ed69734071ff,
+		0x2d246cedfd0a24f3, 0x2fb1addb77d7eb74, 0xbb56f91f9c6b30da, 0xedf93ea4227901cc,
+		0x2cd52abe7b0a0c43, 0x31a12cfa1a170ae7, 0x1a3aacb55d774a52, 0xa1aaffba4afa440b,
+		0x1a93a3e246fb3f8d, 0x552293ee9ac8e53f, 0x0d306c4fee9ec2f4, 0x635bea1be1623177,
+		0xd9151f8256323489, 0x56b4d589297ae132, 0x4f9ee0b9ecc0e523, 0x607152a6bed6998e,
+		0xfa7f7d465a19ce01, 0x3ccfb7d1e504213e, 0x30ec6c4191f81f15, 0xc83c380514911b92,
+		0x7b2fb39debf0e996, 0x30babd9c5a1e4ae1, 0xdb5d8bb94c2a671a, 0x1950b1872127faf9,
+		0xad56d38999eacbbe, 0xac13de1d7a652b74, 0x237c1d804b85f7c3, 0x6c818d03d3cec12e,
+		0x6e4df8887c8c50f6, 0xb55374dfa9085850, 0x82a36252a2c20c90, 0x313dba98961b311b,
+		0x2f9a672776af5482, 0x267a3d34f05299d7, 0x46c15686689a668c, 0x07c78157268a91f5,
+		0x27d55e0ef6854d82, 0x57feb81086956bc8, 0xf8d6de9bf96c17b7, 0xd4c5dabd62a9cc54,
+		0xddbc017c93485729, 0x74ef57a4b826bad6, 0x9871f4d32080fcaf, 0xca5e0780c5a31ccd,
+		0xcc430d22195b7f04, 0xb11253e6eeea2321, 0xcab0f0b09cf1b319, 0x3f66a84e846c5b01,
+		0xb2ee8f039d6a58ba, 0x3d3e186aabcc66b2, 0x22998675148c7d40, 0xbb874a51cba47f7a,
+		0xedc2bb1a2ea71566, 0x5962727aa28d8cbd, 0xa77bbfc71c30e80d, 0x95cd593f9f98efd0,
+		0xa54775408c761459, 0x126161c1d52dcb4f, 0x5215f9387bdbb099, 0x63dc9256af838983,
+		0xc698cae4fbba3e9b, 0x3badc73dfdce76ff, 0x17aaba341fec83b6, 0x9d82c5c28115badd,
+		0x537f7658803e1406, 0xfe99568cac524349, 0xb17162a7dd840590, 0x6a468d26b05e14b3,
+		0x67d2667bf14c85de, 0x294012151b02ad20, 0x197f521530a2b3a3, 0x6a1733c354e25850,
+		0xc805c14b0fdc61b5, 0xfa8f47b4819d99e4, 0x02972e6e5e4e4a7b, 0x6db7f8df86ac5bc0,
+		0xb819e8847ff2b2c2, 0xe00ca1392d0fbb64, 0x190db27a75eb0750, 0xe1978b4a465891e8,
+		0x9f2e34c52cd1f9a3, 0x53f3647cfed3a32d, 0x12836dfefd652484, 0x7237c333d055f286,
+		0xcca9c82f371b8353, 0xcce508d1edf69086, 0x8efda3af423ca8c7, 0x03f50d57c1bed97c,
+		0xf107d0dee2f1361c, 0xedd56a9cd401ca52, 0xc3df362fddee135d, 0xc0d8bdd3057b5dca,
+		0x9279e30e0627cd01, 0xeaf3d9089b1f1589, 0x63bacd08c1f897e4, 0x6990336586d661ea,
+		0x3b09f78827c3d538, 0xc48e9acc2389e7ba, 0x64a492ef9e308b2a, 0xe5d619e7b16614be,
+		0x6eb6b51b2c0f5b65, 0xcbeb473ec5539453, 0x49c0294b7e1cc4b2, 0xf4a6f6d4653d109a,
+		0xea509aeab0e445cb, 0xc33292f1f05d04cd, 0x5cc35d2341b2543d, 0x476446f7c8be83fd,
+		0x97406df436668817, 0xd77e1db0e06ed675, 0x0e31bc7029205f8c, 0xa89f096e8b4c91a9,
+		0xbea716414ddd24b1, 0x9c1886fa1482be28, 0x0d9eaf7bed299771, 0x27c18869bdf3488d,
+		0xe9bde74adf260607, 0x03dc9c2e279412f5, 0xf8e83e06b33fcdcc, 0xf7ebab20e3ab9690,
+		0xbb94e360ca16c718, 0xe19acab9c0671484, 0x88ee2fead4c8e1d7, 0x370810b98186d5f1,
+		0x26a9faccb4bf80e6, 0x2ee02f49f2ff7836, 0x4cf13c11ba76bc32, 0xd39e5e5656b6f57c,
+		0xb4ecdcb39099c686, 0xeb4534556f88611a, 0xf77ad9175dc37294, 0x15b7d04db2f1bee5,
+		0x19ca2d4ca80d25ba, 0x1686263d725fdcd2, 0xa4f684aebe0b51d0, 0x7d9cdc2206ab40dd,
+		0x1a939b8ecdaf8658, 0xdecbb16f185a5c63, 0x682f7acec23522e2, 0x6641f0110869ce8f,
+		0xf0a52e4176af3eea, 0x1a1d04d745c0c22c, 0x66b36bebdfe09727, 0xa27bcfb67d897b22,
+		0xd7bee9a2bc1d7a56, 0xe2454f4c59ec4755, 0x505c43d63c5465fe, 0x6fdf97425b416551,
+		0x71db911ab261432f, 0x5b8387585a4721c7, 0xad657d0ad1563060, 0x8863c3fd380da31f,
+		0x97c9f8c154d4d34e, 0xeed8ec774081a2a5, 0x22a03ec32754ca1d, 0xbcefe7bc26220658,
+		0x8a3d5341c405d94d, 0x28d1e3342a8edaed, 0x6e7684f94d305122, 0x4f8005175d659415,
+		0x85d0c42e7c3121c6, 0xa450f2a29414fa9b, 0x08baaa980104bb68, 0x0eb9d44e26f7a1dd,
+		0x351d753b8a97b7aa, 0xb9ad6867fb65d100, 0xa8537ef9abcce77d, 0x7980e6afeda826ed,
+		0x9ac92d901d6a6357, 0x16bd02347e007245, 0xf5a8b629704f5395, 0x7d5efebd827de569,
+		0x52127777bd9ad44c, 0x0be1a4b7ff4a1855, 0x9c1632811f284a60, 0x03daf005a3bcf1d1,
+		0xc79a71263cb8461e, 0xc437ca6334b06209, 0xd2742a6bc1013562, 0x1508470bff802ca3,
+		0x186bfc0c50ec1370, 0xc5ec54e98a7dbbd7, 0xd80f4b2fbea6933b, 0xb4b3b3accdfa944e,
+		0x0cba8b240c890e59, 0xa191dec60a193c2a, 0x58389a4ddeead68e, 0x627a70519a659b03,
+		0xeec524c33c5983b6, 0xd77e5bba91c1093b, 0x8c9ab8d88230ae4e, 0x7326abf4b7e4eea5,
+		0x48d8919b81a5a6c0, 0x2f25c2ee81ace498, 0xf6a537927a078b24, 0x3c76dca1835d89b4,
+		0xaaa61671a374645d, 0xdd860b33a56113a6, 0x9b20d50ec415baa9, 0xc10f340d819351c8,
+		0x1d6ba548f8ad0ce4, 0xc3e0e4a02a87ccb6, 0x400cd293e97cceb3, 0x0c034f7fe85f96ce,
+		0xce0cc3475773b6f2, 0x0ade34b05f120387, 0x3f9174f64b33a558, 0x3531261c70b3b17f,
+		0xad2da6ef2427ca28, 0x33b1961d66e69ba7, 0xd960945bee170445, 0xc6d33ee5ad43ed6e,
+		0x33f052a331045d40, 0x0454bf308d44ee2e, 0x356f683269354b52, 0x340dc90c652c5115,
+		0x7f393ed943712d55, 0xb00eaeed2db57f20, 0xa554d9e65d9c0463, 0xf61c4ba2c6bcda9e,
+		0x88c7ca7b4dfc422b, 0xfec012215c84ec90, 0xe8f01e60fc5b3ad0, 0x39f373a88cae92be,
+		0xfd53810b2d48034b, 0x325a3d74b6357b3b, 0x37e7af3245cc1e5a, 0xf25b21dcadeee4ec,
+		0x9908c25f3dc41471, 0x453eea29a6fa956e, 0xa16324a17d4ddd0d, 0x29227d6b65a11dac,
+		0x0c39b39db6f77a34, 0x63b2cccb3e95e3fe, 0x548348adcc8767bc, 0x593b9857a6b85ab6,
+		0x439dff2ee416be1a, 0xd0bdf39e8b14627f, 0x439fb36ddd2c2638, 0x705ef35395d44835,
+		0x0108bfb59af1051f, 0x4963fa4d6cd1f565, 0x9c9544f30986c0d3, 0x8a7cf4cda2ec068f,
+		0xf4063cb58c18451e, 0x735d18558eeaf28f, 0xbefdc4c8b2f00b6b, 0xf161674e3cf097c3,
+		0xbd17f84dc30dbfca, 0x1f9e7637208d6cf6, 0x7910fa9c68944fee, 0x0d66ccd1927366a6,
+		0xadcd1067f106f975, 0x157c648922cca5d2, 0xb689c86e575dde49, 0x0dec30e8e4531af3,
+		0x7b2a2755055ae0e6, 0x2012ffb15d914304, 0xf4a18d7442ef90ef, 0x9fc6f5808f89ad7c,
+		0x67ab805822adb871, 0x81f2d5c4a9631ca4, 0x1878ff9587bd3b8f, 0xbe65f6da74b9caaa,
+		0x136e06d2db32095d, 0x1dd12d8ba61925ad, 0xe396a505043ac9ad, 0x412be1ae1fe6a700,
+		0x8969b5c8b617f0de, 0x2dcf6599b08c1684, 0xac4ee61a08a1c430, 0x7c2d15254439bd09,
+		0xa4c3e1cf21ff135d, 0x0f8c87cbfc0c046f, 0xa5c535e7804fe49e, 0xaed5dc8165d0b3e2,
+		0xe113366c63a98cf8, 0x720c63dc17b8eb6a, 0xcf138e49d4ceaf57, 0x133baa1b3eba9098,
+		0xfb51e734f1aac45a, 0x4e110f3c8c57ba60, 0xaa2682a7ea25cfcb, 0xf5450daae8a6edee,
+		0xcc3268b3ab7888f8, 0x445c04b7935c43d1, 0xbc5ba9c0d74c69f3, 0x0e733761141f2c40,
+		0xa8d81c8aa6919135, 0x549116e1d6adc86d, 0xaab526eb10cdf8c2, 0xb2a774e45d47d78f,
+		0x6fc01d34bc724e0e, 0x21dd4dd7afe38ece, 0x0944ab1eae7d1854, 0x74c52daec9ee5a21,
+		0xcc2dbadc42d982dd, 0xadf17a251a583b57, 0x50816c0ba80403ac, 0x65b098af06f9887a,
+		0xd3208cc8c64b0b1e, 0xa525c093a764bd88, 0x33fccfa5f436910a, 0x6fac5fc35a04f760,
+		0xd11fedd43bc255c8, 0xc2e41d55ecbc5be8, 0xae8acc96981e2ca2, 0x779e8c3bf78ea713,
+		0xa87d81cf49de0b99, 0x40d39cc17e5cc028, 0xd18afac15109ab96, 0xf1a0da35bc758eac,
+		0x81c513833b57d2ff, 0xfab4817d28f7d748, 0xc044dc56360257b3, 0xc9832da26ad8d1a2,
+		0xea00542468e4d60c, 0x85d37a590a9b0ac8, 0x95463dc28d876cc7, 0xeff156a4a166ec5e,
+		0x90711ea70b900b65, 0xdc0ba9bb531bc2ce, 0x00b3234fe94c24b9, 0xd4e5d32c350f2e4e,
+		0x442f88b99a680826, 0x415fa5a0187cf2d6, 0x262a38526d970bb7, 0x592a76b76f953bd1,
+		0xe8f7eb368c4e1db5, 0x590a7bd75daaad68, 0x483d909503bf2163, 0x0a2b771d895edd2f,
+		0xe3af74b264dc12aa, 0x003a25ca45925d0d, 0x89a6476b3a33b7f0, 0x6aabe53f60cd75c4,
+		0x6c4ea5047aebcdf3, 0x0065adcd64af12a7, 0xc0df889a82926aa6, 0x0cb96b090f70065a,
+		0x72e8e75ab4e6aa52, 0xfd0c05687e66a8ca, 0x0cf32668b1d8c157, 0x5bb3107963295215,
+		0x64c4b5054b581c96, 0x92a9246cdb8a51f1, 0xda4f95af89522980, 0xa88bb3ff2b7b3845,
+		0x92ea350188a46219, 0x8911e968e5e8faca, 0x4119bf18c73828ee, 0x2894299974b642c1,
+		0xb67f588c56e114c8, 0x114c1e691784612a, 0xf0fc919c8edac5ca, 0x0a566de8ff62551b,
+		0x986a19c523a80108, 0x9e8782a23779e828, 0x33136a7d2e72ea7a, 0x341a93fce652cfb7,
+		0x80420156c9b6c69c, 0x3fdf582733d0119c, 0xe9ce37922699cebb, 0x1e7ec7ee21514d31,
+		0x4bd1abd537a56ccf, 0x26318c192d7d51af, 0x5a013e2dacba3dde, 0xb41ac4f7e72e51d2,
+		0x507da997086a0b2f, 0xd209b6ae31b159d8, 0xf2a6d2b82bf30a97, 0x31efbc16a27db2dc,
+		0xe8d518bf62c455d2, 0x1009e77c19f75641, 0xb6f5e40976f3a76c, 0x842439d6b585f079,
+		0xb99598f88e20471a, 0xb467c9e406f72cd5, 0xa703d1b363a7527b, 0xe96d5f7e92a6c4a3,
+		0x820aee11e4458e51, 0xfc389c9547ab4cbe, 0xca9592e19ffc4357, 0x87a5b3dbd6d40d60,
+		0xa81f27890b55d853, 0xdfd173c7bcdadd31, 0x228a8187101ab649, 0xe0e0362c42e110d6,
+		0x9d1381f58f243209, 0x8776b8e243a16c89, 0xd81c829a55880e9e, 0x2bb05fef9e880031,
+		0x1d9a394e00e49bb0, 0x2623891d4bf08860, 0xe2fac67ad6204d3c, 0xa94db980bbc0fa9c,
+		0x5e23aa426f4cf9c6, 0xeb3e1c536dbf8deb, 0x3e3b93ed8275268c, 0xbbfea6e0648aed62,
+		0x4505b47a595731df, 0xef4f5fad6c6294e3, 0xeaf9f63320815783, 0x8efe652d3cd6313c,
+		0xbc65134911f2e901, 0x8c3d8fbc97e5dc3f, 0x27b82158481fdddf, 0x5be1bd54d9510094,
+		0x361688bc7d4ca91b, 0x96a7d1368384895d, 0xc9369939bf0a5296, 0xb2099115547fa780,
+		0x8ac5547d0de07724, 0xb1e9b8750d8f2e13, 0xcca56fea0dbf7f73, 0x1deb0abc86b1e5e0,
+		0x7657ae851f5a7d4d, 0xcecf5547c11d2682, 0xebaabf993057ad0d, 0x6516991f17de649c,
+		0x7eb9c8db811173a6, 0x616aedd6e67c05ae, 0xc5d3ee805eeeecbc, 0x84cc419ac3535c49,
+		0x24d2eb9945f6599f, 0xb159e846ea147e5a, 0xf6f455b7be823044, 0x364dfa16c33dbe9a,
+		0xfff866bd33539b7c, 0x228f91a2218141fe, 0x7947349b63c763ff, 0x498c8cea29438aa2,
+		0xd9027d706de184f1, 0x90b8b3beb6aad293, 0x980fb4e8c4f6466b, 0xb4916d916412c157,
+		0x9e11460dae6d83fc, 0xe7cd485489329c88, 0x4e102d40308f1854, 0x342bf4a13507bd72,
+		0x9fd3a5395472755e, 0x6aae27dff9bc38ad, 0xc0d39c547ca79dbe, 0x36040cd2388b77cf,
+		0xf1598e8eec5f74b6, 0x07c06c4ed87d9dde, 0x2b5e989b76cf47a9, 0xed76407e76163af0,
+		0x1804b8491ad4a07d, 0xb5d3047ab756c056, 0x2c1f48373e28355c, 0xa88e26b73a537fa1,
+		0xd686558f09bf4589, 0x8e2e5d9be095eaf4, 0x63cc2a12acebd60f, 0x03f84e00f8050cf4,
+		0xcd87b275fadb6dc2, 0x22214283d3ff502c, 0x227ea3c53012857c, 0x3a26839f0ef21d51,
+		0x2cb5f7238e519dcd, 0x5c8b355e4881a344, 0x474b7d129a95a24f, 0x4dc40cff6ee9d304,
+		0xc9f4ec9be64bc7c6, 0xdca32e5a5d361dbc, 0xd4bb6e81322c477a, 0x718c57b21f0f0bfb,
+		0x9a51f7c72c32a92b, 0xd91694374c3f1b19, 0xcc08f8cee7d35ca5, 0x1c9f1e0de75ead8b,
+		0x808b30c892c08c05, 0x5151be7670c2567f, 0xdda541ac446a5a63, 0xddfaf7faa77bc353,
+		0x31faf0c489bb7ed1, 0xa6e857ed799eece8, 0x2e1ba2a63c0f5c5b, 0xb8075b73792ba130,
+		0x3aa91fc9a967bbe5, 0x8439be2969bc88e8, 0xac00b71c8441379e, 0xf6f455866509a1cc,
+		0x89aeba0207847189, 0x40a41c5d0e386092, 0x48d79a9036e5a7d0, 0x40854fbba5879687,
+		0xf459c81a024f20e4, 0xd9612334caaa7cbf, 0xf44c4d4981be0998, 0xa0ff4ff0c5b75996,
+		0x20577c727c5c4780, 0xd55f91234bdc20d8, 0xd95a533d5c2ef7c0, 0x662d165855a325dd,
+		0xda707fd1dac676f9, 0x00f8939b26a4c908, 0xd1e340f7e5089bb4, 0xb02bc33eddd28190,
+		0x62fef380276e90f1, 0xa717f51e514fd400, 0xd0b7b2a9b187b40f, 0xcb281511bb303620,
+		0xa37ed1af6ce9f571, 0xfc1bca3392a6ca0f, 0x1994db84847e17da, 0xdaa694bd5cfbf270,
+		0xbb56aa847f71d014, 0xda5eec7938296025, 0x9f8df0f0b444a1c1, 0xe6d36acde3725a65,
+		0x83861b3b47860100, 0x6079a423bc4b9595, 0x411d1ecb354b512d, 0x6d1b4a8ea8522299,
+		0xabda884c8ec800ab, 0x565d8156c4b54dfc, 0x03b7d7eae437da58, 0x9a5e827c8021ede3,
+		0x99a1c8c421edb390, 0x6ac2eb27c2962744, 0x5778e75ce0875554, 0x8369a44411286d42,
+		0x1b6281a31aa8334a, 0xd2d16b9149c53ffe, 0x373271e264e37773, 0xdbecce5288bbcc53,
+		0xae346f9a7d473499, 0x0db5ca51d7a4dda4, 0x7015bb9c5ebd209d, 0xae6a062fdfc80d91,
+		0x0749e230159f20cd, 0x63e29e45883e5c43, 0x40a36618b5ea34f8, 0xa00c8095c0b2c4bf,
+		0x524fbc25ddc4aa68, 0x770dbaf0973082f7, 0xa1b634caf0ef4ffc, 0x72bf865966b90b4a,
+		0xee8920cb0041ae15, 0x15af87a33c716941, 0x1f1a5a7c7d3b05a2, 0x38eb467ec90e26a7,
+		0xddd01296ddca64d4, 0xc0d3eff3c5ceecbe, 0x1ba5117c251d72cd, 0xa8ee2cb5f5c56932,
+		0xbc0169589f506ddc, 0x8965a9a67beae749, 0x93a953dc1f149145, 0x0334f47686ba0660,
+		0x50e4f5e370a38107, 0x45cc35cbdc4c1bc4, 0xf05be2513bd3dd0a, 0x7be24ce32411772d,
+		0x10aeb99566e98e31, 0x7362a7e42af9b0b6, 0xc5010b84dd9724f4, 0xc552e8b0b9f6f8fc,
+		0x3351cc91d26afa1e, 0x8a6f171e9c541d05, 0xfb5fe2ee9024d9c4, 0xf165cfe8db2a2dc9,
+		0xbdc200cec8defc81, 0x1ca4bfbb89dc5464, 0x592e2d92047f49e2, 0x6cb52e6f83b82ebb,
+		0x327022d827d8846a, 0x601656352a5736dc, 0xa919f91dab7fae24, 0xfcc71e4e4dcfdb97,
+		0x468a959ba1289336, 0x4dc7802046459220, 0x89a12a1d874641dd, 0x17577c8f61e3afea,
+		0xa84d9aa33d332cea, 0xd959fcd765dcafc4, 0xbde11ec482ef118a, 0xb20c28b5aa528d27,
+		0xd601f514baf4a8f1, 0xdd2aa132303a6f62, 0x7a4d917bf4d0ef8c, 0xb840a06c29a6bd4d,
+		0x77ce8828fc7f3197, 0x3b3d092001dfe67a, 0x757df46bb3ba4ed8, 0xcf931e1f65dd3e17,
+		0x67dcb81efd0ec50e, 0x7c6396b82df38d46, 0x992ec407afeb679b, 0x087244fb46b30246,
+		0x2162add66f135347, 0x85d6b09da5b4991a, 0xd86cfd47fe700125, 0x18d0694caa997d71,
+		0xb717bab00322997b, 0x22fcc2340d898762, 0xb4a4394c4ea8a5ee, 0x2a3c4e9748221e1b,
+		0x4fefe7e6d2bb6290, 0x438e8fa5455d23a8, 0x99868f1c21553857, 0x45b13fd6b8cccc60,
+		0xdd16ffb4a3d65d7e, 0x517caefc8d3eae91, 0x2ff5e27b8ef2eac7, 0xb22aec06b8090677,
+		0xc82491b13e6308f4, 0x76cdd738ab389285, 0x5a9890d8e86805c7, 0x63931e31d34bddfa,
+		0xfc6ab3de725b4ad4, 0xb82b16c2560b1af3, 0x3b5cc75c1a5f2ad9, 0xd60cbe53663fd9ab,
+		0xf21b1bdc18073c2f, 0x964ddc9666bddee0, 0xb03303d67beda6d3, 0x4fb1077ba5fb0b7b,
+		0xe6c2157e3a3a2bfb, 0x7222122f79bb0171, 0xd4607728d4473633, 0x81294817f8b06c86,
+		0x10a942c910872f43, 0x2d6ba2bfadbe48b4, 0x7610c5ec2f5db7a2, 0x0ba9737358d838c3,
+		0xdb479279a2e6ab39, 0x46ff30244c0e0497, 0x455676239c57912b, 0xf1cbda453db1266b,
+		0x8c526130d4322684, 0x674ba849a6e2bf20, 0x94829874e4975a0a, 0x3b5008d14c692092,
+		0x5f403844d6b73be3, 0xf410b05043ed58ab, 0x730ba772c2dada31, 0xab50c2c06edcf2db,
+		0x4ae7c3368a02cbbc, 0xc4cf954f5868f737, 0x87ae6e596ab3164a, 0x440a98f3f85186db,
+		0x02af74dc5c444891, 0xaea1845d5b7e84b8, 0x13e174778f3fddd7, 0xbb14b00082c3670e,
+		0x99cc716726730b89, 0x926655a0e96d5dfd, 0x775ccd15a9b6bf85, 0xdb3ad90c19006098,
+		0x6ec1f741a0e43f77, 0xedbec8dffb7f0414, 0xb149dc5d51ed071f, 0x8cbd2698187dc6aa,
+		0x5fb21736e2f8a8fe, 0x4b490434da9a5171, 0xfba966dee140485b, 0x2e03952ad95fd04e,
+		0x7b3d01b3c53de262, 0x7a3b9c03c00eb4b1, 0xeffd9cc0147ee870, 0x4585ed3e94795ec1,
+		0xc87c803bd2038159, 0xff65ab538d2c4494, 0x48e9e5eafd66d0f1, 0x39d0e16f0ba8ba7e,
+		0x5ebf54db952e7525, 0xa6fb82b5fbed8226, 0xda4ceb85da6ac705, 0x534c5fc4c3030e64,
+		0xb6f4ecefe0dc41e5, 0x366a1b5b157e97f2, 0xcd6a57d94f6f83e3, 0x5f29c47c6d8057f2,
+		0x30c75b16b048ae8d, 0xda843052b79bb88a, 0xf78b69280dcc4c42, 0xa7d5233d861650fb,
+		0xc6462a1970ec567b, 0x625c978821a697de, 0xee0541b310231fd0, 0xfe3481ab3104f80e,
+		0x9b105c27ce64ed25, 0xb817e00ff978ee6c, 0xf7dfaa5a235627b3, 0x45f47f20cda46af6,
+		0xa3111fd41c0f9218, 0xa225a3a52c86a17b, 0x5a95e5b87844f7af, 0x53da9f857a4725fa,
+		0x00fd546f0e931c0f, 0x71df97022d48aeb8, 0x91bfdd8636fe970b, 0xe4cac9cd03cdfff3,
+		0xddf24d4e668a6801, 0xc5e2fcb3cfc29053, 0xedcea31d02ef5afd, 0x846a906fadf28ea8,
+		0xbc42020aa632bc62, 0x72c311a3fc081d8a, 0x28badf695510bc81, 0xc970a66fdbb33deb,
+		0x53e111ccc545f6c6, 0x3fc637bd32a6eabb, 0x8c4ceff03b72c899, 0x38c287e2f5d48c20,
+		0x9915704f36454ba5, 0xaaf427f2b4eeadc2, 0x6f471e79873c9b3d, 0xcfd011bc462f66e2,
+		0x9928e98a85272445, 0xf81ab78643002074, 0xc9714996bc1cd052, 0x1cb71142760c9c1d,
+		0x1751be0a3d5385cd, 0x7d33a2382be80ad8, 0x02a5cae01c0dcf55, 0x09f5af8157595824,
+		0xd2558629122b4c6a, 0xdf825f437f4b549c, 0xaeb48a73fb69a3cf, 0xeaa29fea51511126,
+		0xc9b087135b5fe0f5, 0x2aa1deaab6e113d3, 0xba492b459fd83252, 0x128030ba5f503cf6,
+		0xb9af3bb73bbbdddd, 0xff73a69501e4e82b, 0xd2fa67d1a02cb395, 0x0409d3ab5c50da34,
+		0x9872f11d193cff2b, 0xb1dd56b48f653ab1, 0xda557a09a44d1e82, 0x58598233e1bc6bca,
+		0x5ec8c31679dac43d, 0xa4b6c260b492e0fb, 0x9e9a80b5b5a2bee0, 0x7f930e07ad36b5b1,
+		0x9b25dac6b6273fba, 0x44fc17e5f611f1e3, 0x4fe7511cdbd5096d, 0xb57177190bc1d5e7,
+		0xfae653864409cf2c, 0xef590ef7203f1737, 0x7106551f5186fe81, 0x1e0c325e7d98f532,
+		0x19eb2cff31c18d00, 0xf160591b15b79d2c, 0x95b1e0a3d5da2f28, 0x51da584dca70a71f,
+		0x6b9a4a4063958ff2, 0x47c16ead0a0d7f15, 0x8d024582fd77def5, 0x57a0a7997738953f,
+		0x725a379140115dec, 0x1c4f5761775a7abc, 0xefcb2a535b88cc1e, 0x48e2e6d74475cccd,
+		0xdc6bfbd95f937369, 0xb7605079b72f785d, 0x6476dd5087e78c92, 0xefe73c836a2ea980,
+	},
+	{
+		0xe29198a299e5a899, 0x1516c91cc967fd55, 0x804af46e0f805b0b, 0x1daa70dbdc962ab2,
+		0x8e61d355aa0dfba8, 0x9be97ccfa97b853e, 0xafe9286990d15ba8, 0x29950b1dc1c3de87,
+		0x14bd55925245e98a, 0xccc617cadd07cc82, 0x8f943f622121f6a2, 0x47186ee6276a4af0,
+		0xfc8c639721d23624, 0xd9fc9cd18f9ce9ae, 0x4ad692b4ab3c37a8, 0x08d695a2214f40ff,
+		0xe85fe2ea6495531e, 0xe7dfcc22e9bd8491, 0x932746259d35462d, 0xc2bacf1bcfefdf1a,
+		0x0af8684e51cb1458, 0x6a8bb245a3d8c82e, 0xe39f65689ca050ea, 0x27a9f242d91bb0ea,
+		0x2bbbcdf1589a0068, 0xe305036be58d6aad, 0xee2c2cc758c87c27, 0x12bc3e73cbf66ac3,
+		0x267beadda4f39c4d, 0xcd99fa03a7f6f28f, 0x18cf2fb2a7c7ab77, 0x24b0253f7829b234,
+		0xc8c856990f7732ac, 0xfbab81ae2499286e, 0x4a96a0b50a522433, 0x61a9460f01b3f2f8,
+		0x5804be1997e535e5, 0xe1cccc89c16898a9, 0xf140fd209a7521ed, 0x02d27e99f88ecdee,
+		0x418166640c5e8d7e, 0x093bea54d69d8a09, 0xa009526cbb781a12, 0x826f1fbd1aec72dc,
+		0xc0f1bd45d253c74f, 0xabb4f2410f541ecd, 0xe20f2565ae5efc3c, 0xd1f3090151cc0438,
+		0x97fde66e594df8c4, 0xc44b605645ff695b, 0x7f091a1d6fc9ced0, 0x1e491cfebd0aee7d,
+		0x81a86c61d737c0bc, 0xbbf58c0f09c983e0, 0xa0ddadb751ec6ca3, 0x37b56c0809f578d2,
+		0x41a197c33c346f23, 0x8dc12f9bb380e2ed, 0x0d1064f0e7b876bf, 0x072b072f23cae1b1,
+		0x238b35b7182a6a01, 0x4a49f0a943c5a864, 0x8859d38788b234da, 0xde18362a45468dae,
+		0xc735d9607c8668b1, 0x908d1f71de2e0887, 0x0ba4c96c52b08f88, 0xbaad3a2a8c0afeeb,
+		0x7ddd4d5ad11b0aa0, 0xc3b3577d9fcc297d, 0xcfd8f7d777858cb5, 0x6648eff335703513,
+		0xf8449c30c6ae826c, 0x35bfe271ac36f7ce, 0x86f5119ef9c185ec, 0xbe92cebe0d01ac6b,
+		0x7574294039cfe724, 0xf8dfa6f1b7d0c062, 0x8a2e09f619ef2a5f, 0x3d70880fa140cd82,
+		0x3f0a40fd87faae19, 0x1c81fbe0ad04b57a, 0x416c26be9c13c708, 0xc729d71bfba675e3,
+		0x7fa7ca5692484309, 0xd47071348e49f920, 0x350071c65a31b228, 0x90de4134b79d4b55,
+		0x7f48353a13d456e8, 0x29e92e898551ba16, 0xe4761fe0f2d066c7, 0xcba7e35afa44eb49,
+		0xb43555daee00247a, 0x49dc0538a3938e01, 0xb9b597812fe1f774, 0xc15599d4fda8ac6b,
+		0x108b8e66faceb5ee, 0xa9f19685480f6e81, 0x5bc367a4be42f24b, 0xa9f82d6bca9dc622,
+		0x3e6e4572adcc9fb7, 0xdaa9cf5939943757, 0xb0ff73d062b47cb0, 0xa670d598f2339855,
+		0x32a7d1861e2c2d60, 0xe1f6ed0effa151a6, 0x7d54450389c22495, 0x8421a6ea351a59ee,
+		0x77a1bf87bd134e82, 0x9a7b175c6ba89398, 0x16199c4535d82a9d, 0xf2ef61169c4a4f05,
+		0x10cd5a1196d33402, 0x8c46b5d1b5e8737d, 0xb2e86779c60e4965, 0xe4b1692e178cd6d2,
+		0x789671a9c1058b2d, 0xc1f7deca4218983e, 0x458df2dc908afa9f, 0xf25ff0955350a5ea,
+		0x90628185abf2ab6d, 0xa3db6459b6bc3450, 0xba5dfdcfc0f1af36, 0x7a22a8a76365e3a8,
+		0xbb8fbd1d218cbb17, 0x28e6776c6b95f1b8, 0x4ffeb25e1560ede7, 0xbec800fa56e9c4ef,
+		0x62432e217f4238e8, 0x7f2d9e34366fa196, 0xca41a9df1574f2f4, 0xc005b927b9b90602,
+		0x77dd3ee3c4da91f6, 0xcab1f88c27b50b2c, 0xf6e36e631ba075ec, 0xafe2536722e868c3,
+		0x45d14d9f275ec130, 0xe45c9dc1bfc09023, 0xac1f5f94788a2919, 0x0003144e3a02c79b,
+		0xb10903c11a54bccf, 0x0a1882416a64c0fa, 0x7a5cdf01660f16e0, 0xcec9195da2f43d80,
+		0x2ab13c3c1e45a2d9, 0x610148865184fb2a, 0xf64a5f8a19f3f99a, 0xdf88c42ddd0b4ff4,
+		0xa9daf582e8bbd065, 0x327e083222a3697e, 0xf583a61f989cf00a, 0x6db0886e759ac02c,
+		0x180e1834938b2bc4, 0xaea80c545176ba41, 0xdecfae75fef0cbb3, 0x9e6adb09f76ba3ca,
+		0x4afb658390784e5a, 0xfae1c3ba185cf23a, 0x103a50f8161aa9f7, 0x57c4accd7c5a5ede,
+		0x4d283bf0634e25f3, 0x850d1c5ca1bf4b48, 0xb00e81cb6164785e, 0x845a8466777b1098,
+		0x61735f16e44aa54a, 0x939a0ece21e5a9df, 0xef9f5cd340ffa476, 0x32a96b530135af6b,
+		0x7d2424af274a20e0, 0xce89033f5d25b7ae, 0x8a1996a787180f66, 0x0935f25cb27b8fc4,
+		0x0aa5c601e17d255f, 0xc9a84a0170a4088b, 0xbba0d6efe3f94499, 0x283f960ebe4358e0,
+		0xc16798418e38fbff, 0xca6fac993ff71928, 0x634a7b8e3da94f18, 0x5ab582d97d9328da,
+		0x4d3049b18d97dc95, 0x9933f94e882c2014, 0xcf17a338c8b39249, 0x35b87ec0a50c7f6d,
+		0xcdf4d41a5351e143, 0x613be103e6ce2636, 0x4c0d0a53a3e3bb78, 0xdfba5425d4fe7ba8,
+		0xa9847ef06e7dc8db, 0x0b7ca3c06a81dad0, 0xd39d39a0711f77f4, 0xc328402ebb3036dd,
+		0x06f2e3adc01757da, 0xadb3980834e0785b, 0xa945cf58c9bfeb7e, 0x7bdcce223f225827,
+		0xce4df3df87eba14d, 0x9ecfd070a2294e5d, 0xcd2e318426001bc6, 0x5f02dd10c4ae99ff,
+		0x28722ecc02bc97a9, 0x9ef180ad7fc37307, 0xd0a40fb4b24b94ae, 0xe13f7685e2b41dbb,
+		0x1754e214ecf8055f, 0x4cfa47b494c9c156, 0xcee2855a65fb96e1, 0x2b7606e628e74f95,
+		0x034065e90d1f7f6e, 0x989ebd44992fd5be, 0x5c2ca3fd1d652aa8, 0x8cbf357631d10a87,
+		0xee562787e24b3eba, 0xafeedd4c35f35ab8, 0xf46c14a2cf2f9b21, 0xb3d9213a3d0066f6,
+		0x938a3f5017060666, 0xfe6df4d871fbc8f8, 0xa199d21d31a6ac9e, 0x084a8134b1463cfb,
+		0x6c1b4c335afd3ae4, 0x97bc07da2814ae16, 0x30dbf43b9d34f484, 0x82d7bf377d8ae2e3,
+		0xf0153f5a83af5049, 0x8fb4d0ebc743ecf6, 0xb8358bcda0ee229c, 0xa3de0407b2009971,
+		0xbdc0c4499cdac34d, 0xa98071bff4d524a0, 0xc4985ecef33b393e, 0xa60de4b1198e3068,
+		0xd3f00b7e2ce0d8e4, 0xe1a73b0a77629fd0, 0x4bfc253298779bd7, 0x200a01a8698478e9,
+		0xb18df3346332b7de, 0x95696b212e593d10, 0xbd057c1985fbf808, 0xb3958f729f5141a2,
+		0xef48b9d767fae811, 0x3ee884f3e194c979, 0xcd29186fda684c86, 0xfac16b0cb84bca6f,
+		0xf2fe8a74db8ad06d, 0xfd80a7c4b1098595, 0x984cf72566f94874, 0x90eb535d100f160b,
+		0xe12a5391f9f0eecf, 0xb01a97955d464029, 0xa5eac2f84fe4900d, 0x20d86a407860cdb1,
+		0xcde4690fb5601af8, 0x934423c36e52157d, 0xfa9e1196fc1ba739, 0x987ecf3113e5aa17,
+		0xc51d57ccef21dd1d, 0x5c6e89617be37366, 0x08f3a0cd90c65b31, 0xa8512b61f1136fd6,
+		0x02ae39c18e55880e, 0x106b56b34ef9d415, 0x0df89b248d1b23c2, 0x7dbc55da4f37f4ab,
+		0xe3e6b04992a6198c, 0x85b360c4455a266b, 0x84acac4fdd5eea0c, 0xe3598de1a9a48c60,
+		0x2e9c657b5b52c444, 0xd24f753d4f8cd233, 0x1f2db27dcba887e5, 0xac8f5b92a29bc00f,
+		0x167626f71a9a46c1, 0xb568e8a5f8786bf4, 0x39c8a5395813e6ac, 0x45261f17f2e1efba,
+		0x62b730501e6937a0, 0x5c63ffe776d42ec1, 0xbe9e3e1399fe59dc, 0xf5f1d40d61ca474a,
+		0x6f995bd32790bcd5, 0xf9e14c58ac70219c, 0x147bba4923866433, 0xd013a7a3a0e4c16a,
+		0x96587f39b1a79657, 0x0388b68ed3e4e25c, 0x63202eec20c8e3e6, 0x214fcc4ed8512dc6,
+		0x3c332c3e5769b7ea, 0x01fb6d55a909b39e, 0x9393eefa2ccba32e, 0xee361c3b29730011,
+		0x07b901687cf919fc, 0xbfa1cd4982c71e8b, 0x8ae9f1bd31aaad77, 0xbf7b6b653d12f22c,
+		0xc64eca4685191ed3, 0xf4dd6c3812033c2c, 0x313825ea54ac217e, 0x0ed7a59e4117c2cc,
+		0x853a96d3a25d3f96, 0xd35bd3eb43ab6321, 0x4b7e1ca80dd3e1a5, 0x2d4c3eee7331e5da,
+		0x0d9e06a356bd1ef1, 0xe682321d967a9852, 0x3cc62184fa29c5a4, 0x707e4a245810104c,
+		0x10ea7fc66321aca5, 0xff7b44e42fcc6095, 0xa37d182b55c7f1c7, 0x843480407a7d795d,
+		0x414501356d646306, 0x88e6488f83e1fa9d, 0x2a7e7b7d64d20d11, 0xa6a14e9f92fabb23,
+		0xe8d53c411d866f99, 0xc6b22d3b3c001a4a, 0x270a7202800bd04c, 0x0b7faea12c9cf22d,
+		0x74a079a44da69c4d, 0xb7945857b2a76391, 0x95b540b36556117e, 0xfcb31d9326942eb2,
+		0xf3d519f6c33b8fe0, 0xdc3993a8808e733f, 0x6df6eee2c1fae2af, 0xbc0fb1d48641871d,
+		0x3bbddd5e2913e3ea, 0x9bfdb267bdcbed46, 0xd23efeba4595ed80, 0x1b5b7cdb9ef957ce,
+		0x546657d5acd81e4a, 0x85e23e8160927d01, 0xe3713fc6630ebf7f, 0x54c66a8e015b8f5f,
+		0x9773e3dea37348df, 0x4124424f92d84e6c, 0x6b3919aebb7cae25, 0xe449752dae584c95,
+		0x99390390990452ec, 0x06ab445b863105da, 0x04c000f96c5a8f52, 0x7fd5feff409c57cc,
+		0x6823680051edfe15, 0x0dfad21f75f72a8b, 0x7f3105126f78839d, 0x13a0657ab27ad424,
+		0xa1639507a09283a0, 0xf7468eb05bc58386, 0x11c441771f1557f8, 0x53d128c833247e10,
+		0x5a6766a7929ae38a, 0x3bb78525f7eb91b0, 0xf2e8ec7dc85f6599, 0x6837b8294241db98,
+		0xa25e6f115348db5e, 0x27ab4f61603b41d7, 0x64563526de6033ab, 0xa8f1a2db529a3351,
+		0x1bbb11d7031970e2, 0x0e9b70dccb0696ac, 0xfb093a3b0ba20e05, 0x7d8471543afe6e72,
+		0x7da75c437a7272a6, 0xaea2e8287de63a01, 0x80f1a06bc5586046, 0xc02d4f86a3c9ad33,
+		0x11eb06fc147b3a95, 0xea201227ed0f73b2, 0xca68480a65f27f7b, 0xd587e746e30237dc,
+		0x82a752fc2d5692ff, 0x40ef07b6825994b2, 0x155fcb9dcbd8bf5f, 0xc6b2b1fc6f8a32a3,
+		0x921b01bae04ff607, 0x138911fd72306bdf, 0x1731050deb687785, 0x80e390e5a117eee3,
+		0x585b8694db10a334, 0x918c5ae9c98e36fd, 0x621924ab2d69aed7, 0x52c5dc902cfedd34,
+		0x0c045f00d7259b2b, 0xf8cba5de4d99f63e, 0xd53f38646a2450f4, 0x0ca3c5e3cead557b,
+		0x23c6fc35c2c6f355, 0x2231f0a2e1316283, 0xaa746b8566b806be, 0x6a33f8f5b115ad55,
+		0x4fc48cf8081dc1f4, 0x54a3a05db8653cb9, 0xb0f899cd3d5010fd, 0x3fb5645346550034,
+		0xd8b4f387c8c745f2, 0x67571bc144067c03, 0x57c67f55a2f8ecaf, 0x1a14471b5ea3dbe7,
+		0x3334424955fac06f, 0x3686f8471e219d3f, 0x63b50b99e817ad1f, 0x4a17dc8588f233f4,
+		0xe67f5562fb374805, 0xd2482196ff5ab56e, 0xbcf43ed29c1cf95c, 0xb3102ebdceb72d23,
+		0x0f2108d6692c2325, 0x9e687e0a7e046e01, 0x008c3fe23d33f925, 0x2cc7a142b5989b22,
+		0x42f9bcb2bf3b61c6, 0x7562d16ca8fde3ca, 0x68802ac383a0ced0, 0xbd31e60ee925ff5c,
+		0xc0b6747171da21b8, 0x8e1691649bd30095, 0x5af4322883527494, 0x2c0c926e8b9378c3,
+		0x97e4496980079351, 0xf6c6fa6958950ba1, 0xde404e8782918203, 0x9db851efe24013ce,
+		0xe6e2c98635731776, 0xe31aab325a589581, 0xd7bfc0b3e32a41f0, 0x0afd19805d19530c,
+		0x93798e72c21e6909, 0xe530d6dd55ed8619, 0x85433c6f24dd1fa1, 0xf85d8a36b0c714cf,
+		0x736a4f19bca577cb, 0xb1633d3156e87822, 0x5a64643972f96497, 0x23fd1821fafbad4a,
+		0x86e6445532f81018, 0x108499692d8da89b, 0x458088400af7b434, 0x75b0bf2731722289,
+		0xa546a4e4c055b168, 0x92fbc835c7217563, 0x1bbbaa89caab73fe, 0x0b060a56460f8ac3,
+		0xa19b1a712678945a, 0x0a7f043089eced2d, 0x5be9e4b2a16857b3, 0xa54661b5e85b0d57,
+		0xd3518c7bce57af38, 0xf7f034fd9b7a051f, 0x31fa9cf793f9d771, 0x5c6c7d529d36d196,
+		0x42b046c2d37d8ad9, 0xaa3657db104127ad, 0xa88891421e1dadd7, 0x9052d7e886fb011e,
+		0x88e8e1d73aecf054, 0xf8c0a84eb13b8ffb, 0xb8e4bc81f014b70b, 0xabafed1f0403852d,
+		0xb697c9e3f0d27519, 0xd1545f9e35582dfe, 0x5e9e4db5b664c556, 0x8ae51a55fb26921f,
+		0xc6e84bdc34619cd1, 0x33741a6328045d3b, 0x8548d1cd879f34e7, 0x5721939d00a824b0,
+		0xadd5e6ba4d2a362e, 0x6c50e85d26540c24, 0xe99044343a12832a, 0x10ee88788f400fb3,
+		0x06ce8fda6ace14f6, 0x1a6c6e6e78848c56, 0x1caaef6dc3d77f9a, 0x798fef40faa04215,
+		0x0f4d11aa9ff6f09b, 0x500173d8acff9bbe, 0xaa170eb29e932758, 0x9d8285a0d5f91bad,
+		0xaaf003f9fe9c7a4e, 0x90e70c922dd03afe, 0x333f7bdbada9106f, 0xed71416bb2b730b7,
+		0x61fbfaa5a56e284a, 0x069997cad8b4bd10, 0x2b38234b2293d914, 0x68c43c17f2c8a330,
+		0x2e90d87925300d89, 0xe61b66fb7d75c2d7, 0x96d6758fed80c33a, 0xf8f6c68ab0ca3713,
+		0x44cd792218fe6a5f, 0x4bc2c2e04c3fe393, 0x90228c45b90f3322, 0x516a66f1f7f61521,
+		0x9e093bb6902cbe49, 0xc8c2b395f031b4d8, 0x50ef56aecb9776a9, 0xf751af48ed38c7ed,
+		0xd21b674f05703071, 0x50d91fdf3f913e7a, 0xb301af43f4dd65d5, 0x81d73c4c565ced41,
+		0x4a453e711a749c64, 0xa1695c5c7c1a070a, 0xf72c8ed7530bd8b5, 0xd3efcb866df28d2f,
+		0x68e5a7c93d94e195, 0x675447442516214a, 0x3909c1c0ee578034, 0x7a2f79a4b50b65b6,
+		0xa0107dd49dee81e3, 0xc9b72848328ed645, 0x66b98391619df677, 0xf96489a73d22bf0f,
+		0xdf17c8eee962fe2d, 0x4cd8b777bf71e53e, 0x8bbfbfcf857bd453, 0xdcfeaab73c018f9a,
+		0x3499eb5a1c8a480d, 0x23a806983b48a286, 0xfd11cda77dbd4d67, 0xbed0051566176f04,
+		0x9f8996459f6c9f58, 0xa4fbecf4d02ac7aa, 0x42e0dedac8dd338b, 0x48759f22df6694ed,
+		0x8a9eb366a4f1acae, 0xbc8683ec36b7947a, 0xdfc68998d0bcf401, 0xe5204c3edefde3ab,
+		0xda36420438fa6e70, 0xc40ade3256f3575a, 0x0ff585be30172e4c, 0x7ed9808cb30acef0,
+		0xb6a3114ac57a7f86, 0xe6607973f3c1e5f6, 0xe9fe3009f87c3c08, 0xc7d73804f17bd235,
+		0x24aef8475837b3d2, 0x3eb6bd5f2aa946d4, 0x324cfea8759c76b2, 0x8a71946f72b744ea,
+		0x612b50ede0e35252, 0x94c86988732e8b66, 0x02396533de2fcbbe, 0x84bfa279dbb61b0c,
+		0x00c34fcb4243cac7, 0x9b4b389784424f74, 0xc217229f60819e33, 0xaa331ea2ec5cf46f,
+		0xea158fd1e03bdbd2, 0x8a941ed472cac4b4, 0x1d19b73f2c7d1be1, 0x0fbbfe46b927cc66,
+		0x88c7b7ba5321d7b2, 0x65ea7f6f9f46a226, 0x1d0c902399987a48, 0x3664f0dc4740adb3,
+		0x763c2718582553ec, 0x16dd8abbe988eb06, 0x01855d5681618406, 0x92d7617a500b8bd9,
+		0xa4a711a59a751db5, 0x6bf69bf1ef591baa, 0x74f507455fef32df, 0xd2c15af78c41a8f6,
+		0x16b70b4c8c64627b, 0x7d392dd6324edc5d, 0xc175770f34782c1d, 0x5d6f881d708b22fe,
+		0x0225caedbc6aa9d9, 0x338f16228a536727, 0x2cef7435e64a7a1d, 0x1b89186de8196a1c,
+		0xdb6e4075a02ecf95, 0xb307c7bcebb58b86, 0x76c967956f688266, 0x6291f53f55f13179,
+		0x7e50f7a1fa38098d, 0x8eb8a6a8f7c4efc3, 0xaee39fb5d664ecf1, 0xe9b6b5e0798fc66f,
+		0x947c464c176d41ed, 0x515d30c8bf685730, 0xdbc56de43e0be872, 0xc18f799df7ef5e76,
+		0x49d7e0bcf2b3dda3, 0x4996f5443152f0e1, 0x4eb39e340fd15d1b, 0x811f2f4783767ba8,
+		0x7b8ac6a6e98e7eac, 0xf91c39c942c37d7c, 0xca876ba5014e4765, 0x0e83dd516e51c97c,
+		0xe8a42cd6e8ebf7aa, 0x1e29bd8d2d020931, 0xb33854922d44b7fb, 0x4f5304343242bf66,
+		0x3fbc494a43781329, 0xc42502fc31aa8d1a, 0xf5e93f8b5ab997ea, 0x96c3755239e115c4,
+		0x3675c8d3145c6fe3, 0x351a40123465b545, 0x7bdc8329435237df, 0x45c1be3155fa8f81,
+		0x6c6bcf8bf55300a7, 0xbc3bdec3ec1a9140, 0xcd76cd99b1954557, 0x28ef7126aa02af8f,
+		0x950bb2f3ca6de5c1, 0x562693a977a3e18d, 0xbaf6e17b38de2294, 0x74b6aa096094d2e1,
+		0x1257a6472413748a, 0xdf8844b02110d8ab, 0x1434bda2b16d8453, 0x23d4dedd3e7a7007,
+		0xed2ddef7f555217c, 0xc5eba9e40154f0b4, 0xfbbe713388e31139, 0x4aa468872503ca70,
+		0x88252ff842d74557, 0xde68c847a3240d49, 0x1ee9859db94c57e2, 0x84acff8522426ec4,
+		0x9395bceee5edda9d, 0xcb3e7af543b1ec1e, 0xa46e54ec2d20fd95, 0x1169865f572b5013,
+		0x7bc54668ff23054b, 0x03147ff2de91542f, 0xffa837a878c64903, 0xb3d0a00941646ca1,
+		0x3faab1a44147a858, 0x82d9b951687e7757, 0x58965dee9966a0b1, 0xd0687bc27a4f5fc0,
+		0x366651bd78684611, 0xebd94fd439c397fa, 0x88ebf69ff1fb6f17, 0x0261b8e8c4b79f9e,
+		0x24dbbe7fa9fa2e9b, 0x41472aa4754da559, 0x3a94564a3dc25a9c, 0xecd221cbb969f50e,
+		0xa6a1a10b216b64d0, 0xd33c4aa7d47989ad, 0x920214757cdc9cf5, 0xcc754e32d1f9b189,
+		0x454ee18130e6799f, 0xc5d6e27489f2990d, 0xe1efcdc96185a590, 0xcd0213966b20e050,
+		0x857e59cd667faabd, 0x0026e9fb73d74b5b, 0xdd92de14c458e516, 0x401d03cc4c8701f8,
+		0xead57722e8d3c136, 0xc0bafa9b599cfee8, 0x6db8e9a5b2570017, 0xc9e2f455596df303,
+		0xdff34448f342618e, 0xccd42009df464f1b, 0xae5b42aff638fd0f, 0xc9e6e49d4918038a,
+		0x985bddb75b09f5dc, 0x6005c1efdb15e0dc, 0x87314c3c81fb4088, 0x60c82747004f1df5,
+		0x34d77837c02b23af, 0xad8b92ac8c0faa84, 0x9f527e90db1b7490, 0xdb74a570a02b2e18,
+		0x9227f8c8cd4056f0, 0xe77f69d3e52dfc19, 0x6bb145f87624316c, 0x90ccd6a8cc678e87,
+		0x0983a43acf620092, 0x050019da86e49aab, 0x8e886b1190aecc2a, 0xdcc4e2a958713f90,
+		0x6d1383c31641843c, 0x28212fa4ae25db07, 0x31b4d26841e9077d, 0xfc53c6d273afc23c,
+		0xe8c7ebbbe7a7e2b3, 0x9fb5d1a20ec6f51b, 0x0df7e9338c5cc9de, 0x2e273b3a937393b0,
+		0x5c95e1dece6aaecd, 0xff02eadd88367161, 0xa7c7f3e78a2e1c53, 0x7b13e6230215457b,
+		0x4ac12b201b7af64b, 0x9ac67e9816ccc30d, 0xbd599d917888d906, 0x6716f971537c669f,
+		0xd845e369a843ad4d, 0x78c12d424ce2725b, 0x1b768f7493c222a8, 0xa1e73178fe53cd7f,
+		0xab03e288bb41886e, 0x72830c517348f61f, 0x285c86d19d8ba64b, 0x7fedcb5f410b5841,
+		0xf1150812af19ad85, 0x5cc4bb4d54820c5e, 0xb0c269d1986c2a0a, 0xc462e9bc7299cdd9,
+		0x24e82403e5bf6c33, 0x840ce28a5a6ab792, 0x27e43011e82b5a1e, 0xb798ec591ed270e2,
+		0x98123fc0a1bfc5f8, 0xbb27b6753449dd91, 0x8469fef1e40102a9, 0xbfab82c2a0c84658,
+		0x6dbb538fcb1ff99c, 0xa25455aa4c68de60, 0x214406534409e737, 0xd19f108b6024e11b,
+		0xe279a83e2ceb914e, 0xd98333ad7089f64f, 0x79f15db980b53be0, 0x2c446d362fa9ee3b,
+		0xe2c3ede63187c33f, 0xd7b9ea4fc2886b31, 0xe785d29468571d79, 0xe06645032fde129a,
+		0x4e0f2ee867805c8b, 0x74c1583300ec71d1, 0x718c1a1cedc43a6e, 0xe165d825be6e3270,
+		0xf9aaf7d5ad62482d, 0xf345c3c28ee2b41e, 0xe6f8c770d1cbd3a0, 0x5391148dc580489a,
+		0x70004322ef5881e5, 0x953d90dc50e14713, 0x3d8f23cc47d6f788, 0x818217a4ce5d95e3,
+		0x76f49bc7f543b028, 0xb2e780b48a14b731, 0xf7119b6a95fc7e51, 0x54417d1333236379,
+		0x32ca285c46d8a9cb, 0xa9fd242fa3a4d524, 0x30eb588b65fa6a11, 0x03e2d67d749b10c0,
+		0xb387dc2239441f50, 0xcaed53bf26d4bb43, 0x4cbe80b1bffb2477, 0x7696bcbecd9f19aa,
+		0x26ac7e4615e07df2, 0xb8887f295ccae0a1, 0x7e10860adf597fb5, 0x4155c3204df871bb,
+		0xe0c1504afa2e155d, 0xc97556d1946817b2, 0x595ca414ffca4231, 0x213ec6ae98ee1da3,
+		0x9ffe5045c4049740, 0x86e872b180eb0b3b, 0x5d38e61367ce772b, 0x6bfbc3065f9b87eb,
+		0x5035ce1efb651f6e, 0x62948799ec698662, 0xf3df16ec1d84b34a, 0xd72f2ae852baa512,
+		0x8b9373acf41b7aa9, 0xfb72a62eb9b599a9, 0xecceb0f5348d9d2d, 0x4e12bb042c332ffd,
+		0x93e559c54281607a, 0x3e699bc5c2a10223, 0xa5ec0caaf21d66cf, 0x5f8450b3b9380889,
+		0x6d592f6474959d48, 0x70d3784706c98e0d, 0x5d6c32447d6757c7, 0x9c3775f54fb8b3ca,
+		0x1f8d1ac66b58fa46, 0x6fd1a8df3983cd91, 0xc301edd5eefb12c4, 0x4fed985476f04bb9,
+		0x34b737452d24559f, 0xc24d072eaedc64f9, 0xccb7c34f29e072da, 0x181eff0344c1d486,
+		0xf96ef670250db211, 0xebf20b0592d652cb, 0xdf33c5d211e2b771, 0x9fac6a021cd9c041,
+		0x961bf050e0a37480, 0x392050e1832a36d4, 0x6e7d80303e49819e, 0x12d6f69822daeec6,
+		0x0a179796b5d1749a, 0xd85331b9b5bed6e4, 0xf28409073a5e943d, 0x7df017b32ad7ce0c,
+		0xe39288bc1b7d11fa, 0x6b5f555635433db4, 0x579fa38ab72aa96d, 0x960093f3e3c184cd,
+		0xf8a061c2998f8ccc, 0x9368ce322397d9fa, 0x90d0b6edbeb3d276, 0x274581ef8c7c76ff,
+		0xcab0cff684061447, 0x07d1ad5e3e8839b5, 0xee878b0360ad25d3, 0xa87c8b06d2db50e2,
+		0x379bc4ec68fa1be7, 0xd86df3790ca74fc9, 0xa4f42c9223fb504b, 0xd12e3ffabc3f2d72,
+		0x8dbd931d891b2e88, 0x70d44f9e8dd618ba, 0x6ce8e4438bda5d4b, 0x2652cd32265c399f,
+		0x1a03f8babff1be26, 0xbd6b56e341b03b23, 0x5ba6a0b82b92e9ef, 0x4dcf75ccc903342b,
+		0xd23afcbef886d854, 0x7211e19502482253, 0xb828e0d26f0679a3, 0xa7770ccfde053df2,
+		0x48af49236290415d, 0xebeeb596bfd6abe2, 0x8f68314ca66f65e2, 0xa0f36cfaa7253c7a,
+		0x717097b262f86bdf, 0xbb4884856baf71ec, 0x8afc6d5122a3e362, 0x551fc8d85f045767,
+		0xb4da24c0a9ced6d7, 0x4dc2c12daac50b04, 0xbd99bda5910ab93a, 0xb5495a405575b7ef,
+		0x2f660de2353cc733, 0x63bb2b4bbb882ce0, 0x5569dc71e8351e59, 0x0b6ebee65e0f5718,
+		0x1801fcf0f9e4da69, 0xa33c56c00722aa57, 0x2d085916154cf440, 0x1c6632a5ea34a9d0,
+		0x4d26c42af6c4836f, 0x12eb4a3f4123c48d, 0x12f8f8f64698a693, 0x392ce177f6d78ada,
+		0xc0ee78d56bfbab51, 0x03b37a487195b5d2, 0x723c4fe613155254, 0xd8e7090666ddd9a9,
+		0x74abfe0d69aaa7bc, 0x80e81180ed38cbad, 0xc507d0b73328c457, 0xa5caa4df4dbc90ae,
+		0x3273f5d06a699eda, 0xba40cf828eead9aa, 0xf1a0bb40a37714e2, 0xa92e9c6ee6fd6c7d,
+		0x5eb76a1499e5b349, 0x1d57499fdd09b8fd, 0xca83e670e0268c42, 0x8c85cb34cac242f1,
+		0x21c84268743a4b53, 0x32f8f95690842945, 0xcb433e6d127cddc3, 0x12d8a7e35a8628d6,
+		0x6867298dae015214, 0x42d0b57e03dbeb98, 0x87d0c3f5a7d1323e, 0x80c24f9a18fb38cd,
+		0x13a0f58674241a68, 0x3b2ac7a4611f84cb, 0x8685fe74fb93aed3, 0xd9106a6e0bae50b1,
+		0x25ac2fc56f2f34ac, 0x59ecce9ac937403d, 0x6533cb7c641d51f9, 0xce34d08e96abf9c4,
+		0xa0da90bcc6f09560, 0xc0df23a126f863bc, 0xe4947ac166b28c78, 0xf0e488d77ab663ce,
+		0x8261df9a3b2e4116, 0xa31a7f21e9a5c839, 0xf06d7626c0bce3cf, 0x076341619faaed0e,
+		0x386a57144ae053e0, 0x9240a1ad6c9b442b, 0x5fe3cb6b16d0b0aa, 0x193ffe155ec71e34,
+		0x8569b9a30123aee6, 0x3fb4af81959c6138, 0x8e99b8fb4b974e2a, 0x87969103dc7e2732,
+		0x97cef28af76a098d, 0x6620527653ef85e8, 0xf1ff67f7c32e8085, 0x9115980f051764d4,
+		0xb057d1b8d518f2de, 0x7431b939384a6112, 0x97967bfaf2e5fc7c, 0x5bf58d7c3f2af415,
+		0x3af03282add039e4, 0xa0eb58356f860b4b, 0x6d1a33c021ddd831, 0xba51ddfebd19456a,
+		0x39cc23fe2ccf4de3, 0x51fca1245a8ed3df, 0xce68825a793570fe, 0x02a4497a8ed7d7b8,
+		0x281a64a0f0569bbc, 0x4a9e4205b923cda2, 0x6ef56f64d4b42987, 0xc8e7ef5e3ce045e1,
+		0x19f932af1cf12454, 0x40e0338ebc2423fd, 0x84721050af678cc2, 0x17087aa70cb9d73b,
+		0xcce1e42c788ef6e9, 0xdd2e783200eade62, 0xd3340dac4412e97b, 0xd7875ce0a4ea3c2d,
+		0x761ee859082d7ecf, 0x681f42610d17359a, 0x26fdb500b4343554, 0x6a83bc5db1fca7ec,
+		0x49c8892e62cbe64f, 0x121838a529108f59, 0x097aeddc5f3e73ae, 0x959cc1bab2a3ecff,
+		0xd704b002777e488e, 0x8a3c8f6924d2a552, 0xb34fa4c046eff40a, 0xd4aec75c6874d741,
+		0x2f68e0afcc802aa3, 0x8c7ecd7bb1facc7f, 0x239568c1fc5fdb70, 0xacd9d2844f1dc8b9,
+		0x3c0fab1752531bba, 0xca734176670bcb39, 0x8932523a6cf27ef3, 0xab6d70dff3dffbe5,
+		0xc729cb76e5364582, 0x0490139034091984, 0x673d3cb1adb93ac4, 0xf2b99b8bbe3a3da7,
+		0x1ec8af8685f9ef6a, 0x855c9bd7d1e0d5c8, 0xb1ce1f4489c6c188, 0x05af6800155f8968,
+		0x5a732a72a5cb381c, 0x0229cf6485da35b7, 0x9038f8d2938c08d0, 0x34db125b5adfd5f1,
+		0x3c0b6977b4554a6b, 0x47edb8288ef07bbf, 0xb2dbbe1f083a00b0, 0xa4cf24ae78db8b72,
+		0x7416131423c44111, 0x4f99dee7c23f5fdd, 0x0d1f5d21bdae2da2, 0x089290fb5e1abaef,
+		0x621a95431ffa129a, 0x75d0472d6f6b946c, 0x2e125103bde5e41b, 0xb307ac1a3f60f45f,
+		0xb17a35a2aee77abb, 0x8695ead4b14506fb, 0x3ba9f9e5ee447afb, 0x0760fe999df75a9c,
+		0xfffa13f011c93156, 0x4bdd3f2645f3aa1c, 0x80860c0510573019, 0x2a5f215afa51ba75,
+		0xb10ad68a0ec8b59a, 0xbba59aed04843da2, 0xc81d1ec34681df3a, 0x73e6c82b3a1b2e4f,
+		0xc251506bf80cdf8b, 0x7d41f14fa983c501, 0xb565b7efbe278679, 0xe7e364b31661bccc,
+		0xac80ccda358b3ce9, 0xb72507212fb716d9, 0x0721a319bfcee7bc, 0x21596d7ae3a9de20,
+		0x11ed27a8bcc44d7d, 0x67c240ed2134d760, 0xf0abfa60adc927ad, 0x55b1fa8d4655a688,
+		0xef1cb009f18aeb56, 0xf4d8bdaf913e931f, 0xd6d50b89254c2c3a, 0x456825b40a8ea50c,
+		0xd70862efd4d868ed, 0x78338224975ffbb7, 0xe28428bd519a127a, 0x4f54158ddf05c21b,
+		0xb398a0fb99f5cc6a, 0xc3dd0936b18d233b, 0x11c1a27c0692e9a5, 0x13fec3f104415d20,
+		0xb5ed269c30d9844c, 0x267009982f1e7827, 0xb18bf7e1f3474d2b, 0x173e0d4984f14b30,
+		0xe73006a722320185, 0x5292d00dae52a98d, 0x7889cf10390609e7, 0x0e6a98b310884827,
+		0x5c4d0a68fcb980eb, 0x8d4eeac848919153, 0x98cd62d4c6c68da3, 0xd25476deb555b6cb,
+	},
+}
@@ -5,6 +5,8 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"io"
+	"reflect"
+	"sync"
 	"testing"
 
 	"golang.org/x/crypto/sha3"
@@ -202,6 +204,7 @@ func BenchmarkHashInterface(b *testing.B) {
 	rand.Read(msg)
 	h := New512(nil)
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		h.Reset()
@@ -209,3 +212,221 @@ func BenchmarkHashInterface(b *testing.B) {
 		_ = h.Sum(nil)
 	}
 }
+
+func TestSum512(t *testing.T) {
+	salt := make([]byte, 64)
+	rand.Read(salt)
+
+	for l := 0; l <= 300; l++ {
+		msg := make([]byte, l)
+		rand.Read(msg)
+
+		h := New512(nil)
+		h.Write(msg)
+		if sum := Sum512(msg); !bytes.Equal(sum[:], h.Sum(nil)) {
+			t.Errorf("Sum512 differs from New512 on input of length %d", l)
+		}
+
+		h = New512(salt)
+		h.Write(msg)
+		if sum := SumSalted512(salt, msg); !bytes.Equal(sum[:], h.Sum(nil)) {
+			t.Errorf("SumSalted512 differs from New512 on input of length %d", l)
+		}
+	}
+
+	for i, element := range testVector {
+		sum := Sum512([]byte(element.input))
+		if hex.EncodeToString(sum[:]) != element.output {
+			t.Errorf("test vector element mismatched on index %d failed! got %x, want %s", i, sum, element.output)
+		}
+	}
+}
+
+func TestSum512Allocs(t *testing.T) {
+	msg := make([]byte, 600)
+	salt := make([]byte, 64)
+	rand.Read(msg)
+	rand.Read(salt)
+
+	if n := testing.AllocsPerRun(10, func() { Sum512(msg) }); n != 0 {
+		t.Errorf("Sum512 allocated %v times, want 0", n)
+	}
+	if n := testing.AllocsPerRun(10, func() { SumSalted512(salt, msg) }); n != 0 {
+		t.Errorf("SumSalted512 allocated %v times, want 0", n)
+	}
+}
+
+func BenchmarkSum512(b *testing.B) {
+	msg := make([]byte, 600)
+	rand.Read(msg)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Sum512(msg)
+	}
+}
+
+func BenchmarkSumSalted512(b *testing.B) {
+	msg := make([]byte, 600)
+	salt := make([]byte, 64)
+	rand.Read(msg)
+	rand.Read(salt)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = SumSalted512(salt, msg)
+	}
+}
+
+func TestSumhash512CompressorLazy(t *testing.T) {
+	defer ReleaseSumhash512Compressor()
+
+	ReleaseSumhash512Compressor()
+	if inst, _ := sumhash512.inst.Load().(*instance); inst != nil {
+		t.Fatalf("compressor was not released")
+	}
+	if SumhashCompressor.InputLen() != 128 || SumhashCompressor.OutputLen() != 64 {
+		t.Errorf("unexpected SumhashCompressor dimensions")
+	}
+	if inst, _ := sumhash512.inst.Load().(*instance); inst != nil {
+		t.Errorf("compressor was built by a call to InputLen or OutputLen")
+	}
+
+	var wg sync.WaitGroup
+	sums := make([][Sumhash512DigestSize]byte, 8)
+	for i := range sums {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sums[i] = Sum512([]byte(testVector[3].input))
+		}(i)
+	}
+	wg.Wait()
+	for i := range sums {
+		if hex.EncodeToString(sums[i][:]) != testVector[3].output {
+			t.Errorf("got %x, want %s", sums[i], testVector[3].output)
+		}
+	}
+	if _, ok := Sumhash512Compressor().(LookupTable); !ok {
+		t.Errorf("default compressor is not a lookup table")
+	}
+}
+
+func TestSetSumhash512Compressor(t *testing.T) {
+	defer ReleaseSumhash512Compressor()
+
+	A, err := RandomMatrixFromSeed([]byte("Algorand"), 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetSumhash512Compressor(A); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := Sumhash512Compressor().(Matrix); !ok {
+		t.Errorf("compressor was not replaced")
+	}
+
+	for i, element := range testVector {
+		h := New512(nil)
+		io.WriteString(h, element.input)
+		if output := hex.EncodeToString(h.Sum(nil)); output != element.output {
+			t.Errorf("test vector element mismatched on index %d failed! got %s, want %s", i, output, element.output)
+		}
+		if sum := Sum512([]byte(element.input)); hex.EncodeToString(sum[:]) != element.output {
+			t.Errorf("test vector element mismatched on index %d failed! got %x, want %s", i, sum, element.output)
+		}
+	}
+
+	B, err := RandomMatrixFromSeed([]byte("not Algorand"), 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetSumhash512Compressor(B); err == nil {
+		t.Errorf("compressor with a different matrix was accepted")
+	}
+	if err := SetSumhash512Compressor(A[:4]); err == nil {
+		t.Errorf("compressor with different dimensions was accepted")
+	}
+	if err := SetSumhash512Compressor(SumhashCompressor); err == nil {
+		t.Errorf("SumhashCompressor was accepted")
+	}
+	if err := SetSumhash512Compressor(struct{ Compressor }{A}); err == nil {
+		t.Errorf("compressor of an unknown type was accepted")
+	}
+
+	nt := A.NibbleTable()
+	it := A.InterleavedLookupTable()
+	for _, c := range []Compressor{nt, it, New512ConstantTime(nil).(*Digest).Compressor()} {
+		if err := SetSumhash512Compressor(c); err != nil {
+			t.Errorf("%T: %v", c, err)
+		}
+		if sum := Sum512([]byte(testVector[3].input)); hex.EncodeToString(sum[:]) != testVector[3].output {
+			t.Errorf("%T: got %x, want %s", c, sum, testVector[3].output)
+		}
+	}
+	nt[0][0][3]++
+	it.sums[3*8]++
+	for _, c := range []Compressor{nt, it} {
+		if err := SetSumhash512Compressor(c); err == nil {
+			t.Errorf("%T with a changed entry was accepted", c)
+		}
+	}
+
+	if err := SetSumhash512Compressor(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := Sumhash512Compressor().(LookupTable); !ok {
+		t.Errorf("default compressor was not restored")
+	}
+}
+
+func TestSumhash512MatrixData(t *testing.T) {
+	A, err := RandomMatrixFromSeed([]byte("Algorand"), 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sumhash512Matrix(), A) {
+		t.Fatalf("generated matrix does not match the matrix derived from the seed; run go generate")
+	}
+
+	if sumhash512TableData != nil {
+		At := A.LookupTable()
+		for i := range At {
+			if !reflect.DeepEqual(sumhash512TableData[i][:], At[i]) {
+				t.Fatalf("generated lookup table does not match the matrix derived from the seed; run go generate")
+			}
+		}
+	}
+}
+
+func TestSumhash512TableData(t *testing.T) {
+	saved := sumhash512TableData
+	defer func() {
+		sumhash512TableData = saved
+		ReleaseSumhash512Compressor()
+	}()
+
+	var data [8][128][256]uint64
+	for i, row := range sumhash512Matrix().LookupTable() {
+		copy(data[i][:], row)
+	}
+	sumhash512TableData = &data
+	ReleaseSumhash512Compressor()
+
+	for i, element := range testVector {
+		if sum := Sum512([]byte(element.input)); hex.EncodeToString(sum[:]) != element.output {
+			t.Errorf("test vector element mismatched on index %d failed! got %x, want %s", i, sum, element.output)
+		}
+	}
+}
+
+func TestSumHash512ExtensionResistantVector(t *testing.T) {
+	h := New512ExtensionResistant(nil)
+	io.WriteString(h, "abc")
+	expectedSum := "91a45b8820d632931233d1016c19e939739afeafc4d9a62311197d4c4be3fe61b9efab278da0215885d9b4d0a14cdc25472440e53aa8d19d4473b4b606417ef6"
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != expectedSum {
+		t.Errorf("got %s, want %s", sum, expectedSum)
+	}
+}
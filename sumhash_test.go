@@ -3,8 +3,12 @@ package sumhash
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"io"
+	"math"
 	"testing"
 )
 
@@ -99,3 +103,589 @@ func testHashParams(t *testing.T, n int, m int) {
 		h2.Reset()
 	}
 }
+
+func TestMarshalUnmarshal(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	At := A.LookupTable()
+
+	salt := make([]byte, BlockSize(A))
+	rand.Read(salt)
+
+	for _, s := range [][]byte{nil, salt} {
+		for _, l := range []int{0, 1, 63, 64, 65, 100, 128, 6007} {
+			msg := make([]byte, l)
+			rand.Read(msg)
+
+			h := New(A, s)
+			h.Write(msg[:l/2])
+			state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// restore into a hash using the lookup table, and with a different salt mode
+			h2 := New(At, nil)
+			if err := h2.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+				t.Fatal(err)
+			}
+
+			h.Write(msg[l/2:])
+			h2.Write(msg[l/2:])
+			if !bytes.Equal(h.Sum(nil), h2.Sum(nil)) {
+				t.Errorf("restored hash differs (len %d, salted %v)", l, s != nil)
+			}
+
+			h.Reset()
+			h.Write(msg)
+			if !bytes.Equal(h.Sum(nil), h2.Sum(nil)) {
+				t.Errorf("restored hash differs from one-shot hash (len %d, salted %v)", l, s != nil)
+			}
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	B, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := New(A, nil)
+	h.Write([]byte("sumhash input"))
+	state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := New(B, nil).(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err == nil {
+		t.Errorf("state restored into a hash with a different compressor")
+	}
+	for _, j := range []int{0, 1, 517, 1023} {
+		C := make(Matrix, len(A))
+		for i := range A {
+			C[i] = append([]uint64(nil), A[i]...)
+		}
+		C[3][j]++
+		if err := New(C, nil).(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err == nil {
+			t.Errorf("state restored into a hash whose matrix differs in column %d", j)
+		}
+	}
+	if err := New(A, nil).(encoding.BinaryUnmarshaler).UnmarshalBinary(state[:len(state)-1]); err == nil {
+		t.Errorf("truncated state restored")
+	}
+
+	bad := append([]byte{}, state...)
+	bad[0] ^= 1
+	if err := New(A, nil).(encoding.BinaryUnmarshaler).UnmarshalBinary(bad); err == nil {
+		t.Errorf("state with bad magic restored")
+	}
+}
+
+func TestDigestClone(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prefix := make([]byte, 100)
+	rand.Read(prefix)
+
+	d := NewDigest(A, nil)
+	d.Write(prefix)
+
+	for _, l := range []int{0, 1, 28, 64, 200} {
+		msg := make([]byte, l)
+		rand.Read(msg)
+
+		dd := d.Clone()
+		dd.Write(msg)
+
+		h := New(A, nil)
+		h.Write(prefix)
+		h.Write(msg)
+		if !bytes.Equal(dd.Sum(nil), h.Sum(nil)) {
+			t.Errorf("cloned digest differs on suffix of length %d", l)
+		}
+	}
+
+	h := New(A, nil)
+	h.Write(prefix)
+	if !bytes.Equal(d.Sum(nil), h.Sum(nil)) {
+		t.Errorf("writing to a clone modified the original digest")
+	}
+}
+
+func TestDigestResetWithSalt(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := make([]byte, 300)
+	rand.Read(msg)
+	salt1 := make([]byte, BlockSize(A))
+	salt2 := make([]byte, BlockSize(A))
+	rand.Read(salt1)
+	rand.Read(salt2)
+
+	d := NewDigest(A, nil)
+	for _, salt := range [][]byte{salt1, nil, salt2, salt1} {
+		d.Write(msg[:10])
+		d.ResetWithSalt(salt)
+		d.Write(msg)
+
+		h := New(A, salt)
+		h.Write(msg)
+		if !bytes.Equal(d.Sum(nil), h.Sum(nil)) {
+			t.Errorf("digest reset with salt differs from a new digest (salted %v)", salt != nil)
+		}
+	}
+
+	if n := testing.AllocsPerRun(10, func() { d.ResetWithSalt(salt2) }); n != 0 {
+		t.Errorf("ResetWithSalt allocated %v times, want 0", n)
+	}
+}
+
+func TestNewChecked(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewChecked(A, nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := NewChecked(A.LookupTable(), make([]byte, 64)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := NewChecked(A, make([]byte, 63)); !errors.Is(err, ErrBadSalt) {
+		t.Errorf("got error %v, want %v", err, ErrBadSalt)
+	}
+
+	B, err := RandomMatrix(rand.Reader, 16, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		c   Compressor
+		err error
+	}{
+		{nil, ErrBadDimensions},
+		{Matrix{}, ErrBadDimensions},
+		{Matrix{{}}, ErrBadDimensions},
+		{LookupTable{}, ErrBadDimensions},
+		{NibbleTable{}, ErrBadDimensions},
+		{InterleavedLookupTable{}, ErrBadDimensions},
+		{Matrix{make([]uint64, 1024), make([]uint64, 1016)}, ErrBadDimensions},
+		{B[:2], nil},
+		{B[:14], nil},
+		{B[:15], ErrBlockTooSmall},
+		{B[:16], ErrBadDimensions},
+		{B.LookupTable()[:15], ErrBlockTooSmall},
+	}
+	for i, test := range tests {
+		_, err := NewChecked(test.c, nil)
+		if !errors.Is(err, test.err) {
+			t.Errorf("test %d: got error %v, want %v", i, err, test.err)
+		}
+	}
+}
+
+func TestNewFromParams(t *testing.T) {
+	seed := []byte{0x11, 0x22, 0x33, 0x44}
+	d, err := NewFromParams(seed, 14, 14*64*4, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d.Write([]byte("1234567890"))
+	expected := "fc91828801365750f0267edd5530a301d1471736c485472bbadf22507731a81fd67e0d80cce722a81c6dc690b698f5771713855c5d1927488d79713e3abd81053de2c7db1430b8fb106b3f6aa6b93e54aec351e47bcc176c0df58a0336d24979a064f3acb67a693db399c6402149157b"
+	if sum := hex.EncodeToString(d.Sum(nil)); sum != expected {
+		t.Errorf("result is: %s expected: %s", sum, expected)
+	}
+
+	tests := []struct {
+		n, m int
+		salt []byte
+		err  error
+	}{
+		{8, 1024, make([]byte, 64), nil},
+		{8, 1024, make([]byte, 32), ErrBadSalt},
+		{0, 1024, nil, ErrBadDimensions},
+		{8, 0, nil, ErrBadDimensions},
+		{-1, 1024, nil, ErrBadDimensions},
+		{8, 1020, nil, ErrBadDimensions},
+		{8, 512, nil, ErrBadDimensions},
+		{8, 640, nil, nil},
+		{8, 632, nil, ErrBlockTooSmall},
+		{8, 1 << 16, nil, ErrBadDimensions},
+		{16, 1 << 13, nil, nil},
+		{16, 1<<13 + 8, nil, ErrBadDimensions},
+		{1 << 16, 1 << 16, nil, ErrBadDimensions},
+		{math.MaxInt32, math.MaxInt32 - 7, nil, ErrBadDimensions},
+	}
+	for _, test := range tests {
+		_, err := NewFromParams(seed, test.n, test.m, test.salt)
+		if !errors.Is(err, test.err) {
+			t.Errorf("n=%d m=%d: got error %v, want %v", test.n, test.m, err, test.err)
+		}
+	}
+}
+
+// padding returns the padding appended by the padded mode to a message of l bytes.
+func padding(l uint64, blockSize int) []byte {
+	B := uint64(blockSize)
+	P := B - 16
+	n := (P - (l+1)%B + B) % B
+	pad := make([]byte, 1+n+16)
+	pad[0] = 0x01
+	binary.LittleEndian.PutUint64(pad[1+n:], l<<3)
+	return pad
+}
+
+// extend performs a length extension attack: from the output sum of a hash on
+// a message of l bytes, it computes the output on message || padding || suffix.
+func extend(c Compressor, sum []byte, l uint64, suffix []byte) []byte {
+	d := NewDigest(c, nil)
+	copy(d.h, sum)
+	d.len = l + uint64(len(padding(l, d.blockSize)))
+	d.Write(suffix)
+	return d.Sum(nil)
+}
+
+func TestLengthExtension(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	suffix := []byte("appended by the attacker")
+
+	for _, l := range []int{0, 10, 47, 48, 64, 100} {
+		secret := make([]byte, l)
+		rand.Read(secret)
+		extended := append(append(append([]byte(nil), secret...), padding(uint64(l), BlockSize(A))...), suffix...)
+
+		h := New(A, nil)
+		h.Write(secret)
+		forged := extend(A, h.Sum(nil), uint64(l), suffix)
+		h.Reset()
+		h.Write(extended)
+		if !bytes.Equal(forged, h.Sum(nil)) {
+			t.Errorf("length extension attack failed on New (length %d)", l)
+		}
+
+		h = NewExtensionResistant(A, nil)
+		h.Write(secret)
+		forged = extend(A, h.Sum(nil), uint64(l), suffix)
+		h.Reset()
+		h.Write(extended)
+		if bytes.Equal(forged, h.Sum(nil)) {
+			t.Errorf("length extension attack succeeded on NewExtensionResistant (length %d)", l)
+		}
+	}
+}
+
+func TestExtensionResistant(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := make([]byte, 64)
+	rand.Read(salt)
+	msg := make([]byte, 300)
+	rand.Read(msg)
+
+	for _, s := range [][]byte{nil, salt} {
+		h := New(A, s)
+		h.Write(msg)
+		inner := h.Sum(nil)
+
+		// the outer hash is the padded mode on the inner output, with a domain byte
+		outer := NewDigest(A, s)
+		outer.Write(inner)
+		pad := padding(outer.len, outer.blockSize)
+		pad[len(pad)-1] = domainOuter
+		outer.Write(pad)
+		want := outer.h
+
+		h = NewExtensionResistant(A, s)
+		h.Write(msg[:99])
+		h.Write(msg[99:])
+		got := h.Sum(nil)
+		if !bytes.Equal(got, want) {
+			t.Errorf("output differs from its construction (salted %v)", s != nil)
+		}
+
+		h2 := New(A, s)
+		h2.Write(inner)
+		if bytes.Equal(got, h2.Sum(nil)) || bytes.Equal(got, inner) {
+			t.Errorf("output is not separated from New (salted %v)", s != nil)
+		}
+
+		// the mode is kept by Clone and in marshaled states
+		d := h.(*Digest).Clone()
+		state, err := d.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		d2 := NewDigest(A, nil)
+		if err := d2.UnmarshalBinary(state); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(d.Sum(nil), want) || !bytes.Equal(d2.Sum(nil), want) {
+			t.Errorf("mode was not kept by Clone or MarshalBinary (salted %v)", s != nil)
+		}
+	}
+}
+
+// bitsReference hashes the first nbits bits of msg in unsalted mode, padding
+// the bit string as in the specification, and compressing the blocks directly.
+func bitsReference(c Compressor, msg []byte, nbits int) []byte {
+	return bitsReferenceLength(c, msg, nbits, uint64(nbits), 0)
+}
+
+// bitsReferenceLength is like bitsReference, but writes the given 128-bit
+// length in the padding. It is the hash of a message of that length, from the
+// state after the bits preceding msg, if they fill whole blocks of zeros.
+func bitsReferenceLength(c Compressor, msg []byte, nbits int, lenLo, lenHi uint64) []byte {
+	B := BlockSize(c)
+	padded := make([]byte, nbits/8+1)
+	copy(padded, msg[:(nbits+7)/8])
+	padded[nbits/8] &= byte(1)<<uint(nbits%8) - 1
+	padded[nbits/8] |= byte(1) << uint(nbits%8)
+	for len(padded)%B != B-16 {
+		padded = append(padded, 0)
+	}
+	var length [16]byte
+	binary.LittleEndian.PutUint64(length[:], lenLo)
+	binary.LittleEndian.PutUint64(length[8:], lenHi)
+	padded = append(padded, length[:]...)
+
+	h := make([]byte, c.OutputLen())
+	for ; len(padded) > 0; padded = padded[B:] {
+		c.Compress(h, append(append([]byte(nil), h...), padded[:B]...))
+	}
+	return h
+}
+
+func TestWriteBits(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := make([]byte, 300)
+	rand.Read(msg)
+
+	lengths := []int{0, 1, 7, 8, 9, 8*47 + 7, 8 * 48, 8*48 + 1, 8*48 + 7, 8*63 + 5, 8*64 - 1, 8 * 64, 8*64 + 1, 8*112 + 3, 8*300 - 1}
+	for _, nbits := range lengths {
+		want := bitsReference(A, msg, nbits)
+
+		d := NewDigest(A, nil)
+		d.WriteBits(msg, nbits)
+		if got := d.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%d bits: got %x, want %x", nbits, got, want)
+		}
+
+		// in pieces, with unaligned writes and byte writes in between
+		d.Reset()
+		for i := 0; i < nbits; {
+			switch n := (i/3)%13 + 1; {
+			case i%8 == 0 && n%2 == 0 && i+8*n <= nbits:
+				d.Write(shiftBits(msg, i)[:n])
+				i += 8 * n
+			case i+n <= nbits:
+				d.WriteBits(shiftBits(msg, i), n)
+				i += n
+			default:
+				d.WriteBits(shiftBits(msg, i), nbits-i)
+				i = nbits
+			}
+		}
+		if got := d.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("%d bits in pieces: got %x, want %x", nbits, got, want)
+		}
+
+		if nbits%8 == 0 {
+			h := New(A, nil)
+			h.Write(msg[:nbits/8])
+			if got := h.Sum(nil); !bytes.Equal(got, want) {
+				t.Errorf("%d bits: Write gives %x, want %x", nbits, got, want)
+			}
+		}
+	}
+}
+
+// shiftBits returns msg without its first i bits.
+func shiftBits(msg []byte, i int) []byte {
+	out := make([]byte, len(msg)-i/8)
+	s := uint(i % 8)
+	for j := range out {
+		out[j] = msg[i/8+j] >> s
+		if s != 0 && i/8+j+1 < len(msg) {
+			out[j] |= msg[i/8+j+1] << (8 - s)
+		}
+	}
+	return out
+}
+
+func TestWriteBitsMarshal(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := make([]byte, BlockSize(A))
+	rand.Read(salt)
+	msg := make([]byte, 200)
+	rand.Read(msg)
+
+	for _, s := range [][]byte{nil, salt} {
+		for _, split := range []int{3, 8*63 + 1, 8*64 + 7, 8*100 + 4} {
+			d := NewDigest(A, s)
+			d.WriteBits(msg, split)
+			d.WriteBits([]byte{0xff}, 0) // no-op
+			state, err := d.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			d2 := NewDigest(A.LookupTable(), nil)
+			if err := d2.UnmarshalBinary(state); err != nil {
+				t.Fatal(err)
+			}
+			rest := shiftBits(msg, split)
+			d.WriteBits(rest, 8*len(msg)-split)
+			d2.WriteBits(rest, 8*len(msg)-split)
+			c := d.Clone()
+
+			d.Reset()
+			d.Write(msg)
+			want := d.Sum(nil)
+			if !bytes.Equal(d2.Sum(nil), want) || !bytes.Equal(c.Sum(nil), want) {
+				t.Errorf("restored or cloned state differs (split %d, salted %v)", split, s != nil)
+			}
+		}
+	}
+}
+
+// nopCompressor is a compressor with a large block which does nothing, to
+// write large inputs quickly.
+type nopCompressor struct{}
+
+func (nopCompressor) Compress(dst []byte, msg []byte) {}
+func (nopCompressor) InputLen() int                   { return 1<<16 + 64 }
+func (nopCompressor) OutputLen() int                  { return 64 }
+
+func TestWriteLargeAfterPartialByte(t *testing.T) {
+	if testing.Short() {
+		t.Skip("writes 256 MiB")
+	}
+	// 8*len(p) overflows int on 32-bit hosts.
+	p := make([]byte, 1<<28)
+	d := NewDigest(nopCompressor{}, nil)
+	d.WriteBits([]byte{1}, 1)
+	if n, err := d.Write(p); n != len(p) || err != nil {
+		t.Fatalf("got %d, %v", n, err)
+	}
+	if lo, hi := d.bitLen(); lo != 1+8<<28 || hi != 0 {
+		t.Errorf("got bit length 2^64*%d+%d, want %d", hi, lo, uint64(1+8<<28))
+	}
+}
+
+func TestWriteBitsVector(t *testing.T) {
+	msg := make([]byte, 130)
+	for i := range msg {
+		msg[i] = byte(7*i + 1)
+	}
+	tests := []struct {
+		nbits  int
+		output string
+	}{
+		{1, "0ada4199ae0fdd59afad680f8c8dc6a3e9c9b9c3cdf76d848342351974e62a262bc1bc9a206b8a02e335ba6194239d542adb8aea35ad892208ac5d1b31fd31a2"},
+		{5, "26451909d0cc5cc36140dedbce4e340ad504351f39d3818c2addac0bafa061b7e1eabb0a3f8c26e9fad646a0723d0b248d522ae8d0ef2ba0ab6b11bfcb573785"},
+		{8*47 + 7, "d40870f0278685ae57989f0fd316e1a9706af81a1768ffe6f085d61749c3892391a90d18e197327612b5e11912c0094f1ff04de6164e62dff8b938db6814a15f"},
+		{8*48 + 1, "839e572760196df62d5225b384de120bebb08ae325ebc2bed50f1d4efc894864fe99f460648035e85fbd5225cc4201a3a48bf596b0147c3dbfa1c47b0239ca4e"},
+		{8*64 - 1, "f18b0ebfc1a2d076f4e16ad7ababd7e2045b5ccdfc4d48ffe2ec5076e0b99d5353f758d83c06911323533dfad19c5ea18d012d76784ff05fd941390c583bd2aa"},
+		{8*64 + 3, "0ad4de44eedcae7162f6997a43f1b249a8317641941fc7cbd6e72e4728770787d4b7318bb581dc18660c8ee44a1d4f78f82499d343d6cecdceaa620f1b2626be"},
+		{8*128 + 6, "0faf81013fd19bcf75a66ad00d3e5ae3fc3ec9b40119eb41a56df68ff7151b8a4ef2ae54b6f54a2a21a5191bf54cb18f57d5e5a3af4174c650957a4668dd5f3c"},
+	}
+	for _, test := range tests {
+		d := New512Digest(nil)
+		d.WriteBits(msg, test.nbits)
+		if output := hex.EncodeToString(d.Sum(nil)); output != test.output {
+			t.Errorf("%d bits: got %s, want %s", test.nbits, output, test.output)
+		}
+	}
+}
+
+func TestLongLength(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := make([]byte, 100)
+	rand.Read(msg)
+
+	tests := []struct {
+		hi, lo       uint64 // length in bytes before msg, a multiple of the block size
+		nbits        int    // bits of msg written
+		bitHi, bitLo uint64 // length in bits after msg
+	}{
+		{0, 1<<61 - 64, 800, 1, 288},                          // crosses 2^64 bits
+		{0, 1 << 61, 800, 1, 800},                             // from 2^64 bits
+		{1, 0, 797, 8, 797},                                   // from 2^67 bits, unaligned
+		{1<<53 - 1, 1<<64 - 128, 797, 1<<56 - 1, 1<<64 - 227}, // close to 2^120 bits
+	}
+	for _, test := range tests {
+		// The bytes before msg are zeros, and the chain value after them is
+		// set to zero, so that the reference can start from the IV.
+		d := NewDigest(A, nil)
+		d.lenHi, d.len = test.hi, test.lo
+		d.WriteBits(msg, test.nbits)
+
+		lo, hi := d.bitLen()
+		if lo != test.bitLo || hi != test.bitHi {
+			t.Errorf("length 2^64*%d+%d: got bit length 2^64*%d+%d, want 2^64*%d+%d", test.hi, test.lo, hi, lo, test.bitHi, test.bitLo)
+		}
+
+		state, err := d.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := bitsReferenceLength(A, msg, test.nbits, test.bitLo, test.bitHi)
+		if got := d.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("length 2^64*%d+%d: got %x, want %x", test.hi, test.lo, got, want)
+		}
+
+		d2 := NewDigest(A, nil)
+		if err := d2.UnmarshalBinary(state); err != nil {
+			t.Fatal(err)
+		}
+		if got := d2.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("length 2^64*%d+%d: restored state gives %x, want %x", test.hi, test.lo, got, want)
+		}
+	}
+
+	// A state whose length has a nonzero most significant byte is rejected.
+	d := NewDigest(A, nil)
+	d.lenHi, d.len = 1<<53-1, 1<<64-64
+	state, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	state[len(state)-1] = 1
+	if err := NewDigest(A, nil).UnmarshalBinary(state); err == nil {
+		t.Errorf("state of 2^120 bits restored")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("no panic on length overflow")
+		}
+	}()
+	d.Write(make([]byte, 64))
+}
@@ -0,0 +1,7 @@
+//go:build !sumhash_trace
+// +build !sumhash_trace
+
+package sumhash
+
+// traceAccess is set by the sumhash_trace build tag; see trace_on.go.
+const traceAccess = false
@@ -0,0 +1,9 @@
+//go:build sumhash_trace
+// +build sumhash_trace
+
+package sumhash
+
+// traceAccess is set by the sumhash_trace build tag. Matrix.Compress and
+// Matrix.CompressBatch then report the elements they read to
+// traceMatrixAccess. Without the tag, the calls are compiled out.
+const traceAccess = true
@@ -0,0 +1,220 @@
+package sumhash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"runtime"
+	"sync"
+)
+
+// TreeChunkSize is the size in bytes of the chunks hashed independently by
+// the tree mode.
+const TreeChunkSize = 8192
+
+// treeHash implements the tree mode of sumhash512, described in spec/tree.md.
+// The input is split into chunks which are hashed with Sum512, the chunk
+// hashes are combined with CompressPair into a left-complete binary tree, and
+// the root is compressed with the input length.
+type treeHash struct {
+	buf     []byte                       // input of the current, incomplete chunk
+	stack   [][Sumhash512DigestSize]byte // roots of complete subtrees, from the left
+	chunks  uint64                       // number of complete chunks
+	len     uint64                       // total number of input bytes written
+	workers int
+}
+
+// NewTree512 returns a new hash.Hash computing the tree mode of sumhash512.
+// Its output is not the same as New512's. When given several chunks at once,
+// Write hashes them in parallel, on up to GOMAXPROCS goroutines.
+func NewTree512() hash.Hash {
+	t := &treeHash{buf: make([]byte, 0, TreeChunkSize)}
+	t.workers = runtime.GOMAXPROCS(0)
+	return t
+}
+
+func (t *treeHash) Reset() {
+	t.buf = t.buf[:0]
+	t.stack = t.stack[:0]
+	t.chunks = 0
+	t.len = 0
+}
+
+func (t *treeHash) Size() int {
+	return Sumhash512DigestSize
+}
+
+func (t *treeHash) BlockSize() int {
+	return TreeChunkSize
+}
+
+func (t *treeHash) Write(p []byte) (int, error) {
+	nn := len(p)
+	t.len += uint64(nn)
+
+	if len(t.buf) > 0 {
+		n := copy(t.buf[len(t.buf):TreeChunkSize], p)
+		t.buf = t.buf[:len(t.buf)+n]
+		p = p[n:]
+		if len(t.buf) == TreeChunkSize {
+			t.push(Sum512(t.buf))
+			t.buf = t.buf[:0]
+		}
+	}
+	if len(p) >= TreeChunkSize {
+		n := len(p) / TreeChunkSize * TreeChunkSize
+		cvs := make([][Sumhash512DigestSize]byte, n/TreeChunkSize)
+		hashChunks(cvs, func(i int, chunk []byte) error {
+			copy(chunk, p[i*TreeChunkSize:(i+1)*TreeChunkSize])
+			return nil
+		}, int64(n), t.workers)
+		for _, cv := range cvs {
+			t.push(cv)
+		}
+		p = p[n:]
+	}
+	t.buf = append(t.buf, p...)
+	return nn, nil
+}
+
+func (t *treeHash) Sum(in []byte) []byte {
+	stack := append([][Sumhash512DigestSize]byte(nil), t.stack...)
+	if len(t.buf) > 0 || t.chunks == 0 {
+		stack = append(stack, Sum512(t.buf))
+	}
+	sum := treeFinal(stack, t.len)
+	return append(in, sum[:]...)
+}
+
+// push adds the hash of the next complete chunk, and merges the subtrees
+// which are complete: after 2^k chunks, the last two subtrees have the same
+// size for each trailing zero bit of the number of chunks.
+func (t *treeHash) push(cv [Sumhash512DigestSize]byte) {
+	t.stack = append(t.stack, cv)
+	t.chunks++
+	for c := t.chunks; c&1 == 0; c >>= 1 {
+		n := len(t.stack)
+		t.stack[n-2] = CompressPair(t.stack[n-2], t.stack[n-1])
+		t.stack = t.stack[:n-1]
+	}
+}
+
+// treeFinal merges the subtrees on the stack from the right, and compresses
+// the root with the length of the input.
+func treeFinal(stack [][Sumhash512DigestSize]byte, length uint64) [Sumhash512DigestSize]byte {
+	root := stack[len(stack)-1]
+	for i := len(stack) - 2; i >= 0; i-- {
+		root = CompressPair(stack[i], root)
+	}
+
+	var cin [Sumhash512DigestSize + Sumhash512DigestBlockSize]byte
+	copy(cin[:], root[:])
+	block := cin[Sumhash512DigestSize:]
+	binary.LittleEndian.PutUint64(block[0:], TreeChunkSize)
+	binary.LittleEndian.PutUint64(block[48:], length<<3)
+	binary.LittleEndian.PutUint64(block[56:], length>>61)
+	block[63] = domainTreeRoot
+
+	inst := sumhash512Instance()
+	if inst.table == nil {
+		return compressOnHeap(inst.c, &cin)
+	}
+	var out [Sumhash512DigestSize]byte
+	inst.table.Compress(out[:], cin[:])
+	return out
+}
+
+// hashChunks computes the hashes of the chunks of an input of the given size,
+// on up to workers goroutines. read fills the buffer of a chunk with its input,
+// given the chunk index. The last chunk may be shorter than TreeChunkSize.
+func hashChunks(cvs [][Sumhash512DigestSize]byte, read func(i int, chunk []byte) error, size int64, workers int) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(cvs) {
+		workers = len(cvs)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		next  int
+		first error
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			chunk := make([]byte, TreeChunkSize)
+			for {
+				mu.Lock()
+				i := next
+				next++
+				failed := first != nil
+				mu.Unlock()
+				if i >= len(cvs) || failed {
+					return
+				}
+
+				n := chunkLen(size, i)
+				if err := read(i, chunk[:n]); err != nil {
+					mu.Lock()
+					if first == nil {
+						first = err
+					}
+					mu.Unlock()
+					return
+				}
+				cvs[i] = Sum512(chunk[:n])
+			}
+		}()
+	}
+	wg.Wait()
+	return first
+}
+
+// chunkLen returns the length of chunk i of an input of the given size. The
+// size is an int64, so that inputs of 2 GiB or more work on 32-bit hosts.
+func chunkLen(size int64, i int) int {
+	n := size - int64(i)*TreeChunkSize
+	if n > TreeChunkSize {
+		n = TreeChunkSize
+	}
+	return int(n)
+}
+
+// SumReaderAt returns the tree mode sumhash512 checksum of the first size
+// bytes of r, as computed by NewTree512. Reading fewer than size bytes is an
+// error. The chunks are read and hashed on up to workers goroutines; if
+// workers is not positive, GOMAXPROCS is used.
+func SumReaderAt(r io.ReaderAt, size int64, workers int) ([Sumhash512DigestSize]byte, error) {
+	if size < 0 {
+		return [Sumhash512DigestSize]byte{}, fmt.Errorf("sumhash: bad input size %d", size)
+	}
+	chunks := (size + TreeChunkSize - 1) / TreeChunkSize
+	if chunks == 0 {
+		chunks = 1
+	}
+	cvs := make([][Sumhash512DigestSize]byte, chunks)
+	err := hashChunks(cvs, func(i int, chunk []byte) error {
+		n, err := r.ReadAt(chunk, int64(i)*TreeChunkSize)
+		if n == len(chunk) {
+			// ReadAt may return io.EOF along with the last bytes
+			return nil
+		}
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return err
+	}, size, workers)
+	if err != nil {
+		return [Sumhash512DigestSize]byte{}, err
+	}
+
+	t := treeHash{}
+	for _, cv := range cvs {
+		t.push(cv)
+	}
+	return treeFinal(t.stack, uint64(size)), nil
+}
@@ -0,0 +1,227 @@
+package sumhash
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// treeRoot computes the root of the tree over the chunk hashes as written in
+// spec/tree.md: the pair compression of the roots over the first p chunks and
+// over the rest, where p is the largest power of two less than len(cvs).
+func treeRoot(cvs [][64]byte) [64]byte {
+	if len(cvs) == 1 {
+		return cvs[0]
+	}
+	p := 1
+	for 2*p < len(cvs) {
+		p *= 2
+	}
+	return CompressPair(treeRoot(cvs[:p]), treeRoot(cvs[p:]))
+}
+
+func treeReference(data []byte) [64]byte {
+	length := uint64(len(data))
+	var cvs [][64]byte
+	for len(data) > TreeChunkSize {
+		cvs = append(cvs, Sum512(data[:TreeChunkSize]))
+		data = data[TreeChunkSize:]
+	}
+	cvs = append(cvs, Sum512(data))
+	root := treeRoot(cvs)
+
+	cin := make([]byte, 128)
+	copy(cin, root[:])
+	binary.LittleEndian.PutUint64(cin[64:], TreeChunkSize)
+	binary.LittleEndian.PutUint64(cin[112:], length<<3)
+	cin[127] = 0x83
+	var out [64]byte
+	sumhash512Matrix().Compress(out[:], cin)
+	return out
+}
+
+var treeSizes = []int{
+	0, 1, 63, 64, TreeChunkSize - 1, TreeChunkSize, TreeChunkSize + 1,
+	2 * TreeChunkSize, 3*TreeChunkSize - 5, 4 * TreeChunkSize, 5*TreeChunkSize + 17,
+	7 * TreeChunkSize, 8*TreeChunkSize + 1, 13 * TreeChunkSize,
+}
+
+func treeInput(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i*7 + i>>8)
+	}
+	return data
+}
+
+func TestTreeReference(t *testing.T) {
+	for _, n := range treeSizes {
+		data := treeInput(n)
+		want := treeReference(data)
+
+		h := NewTree512()
+		h.Write(data)
+		if got := h.Sum(nil); !bytes.Equal(got, want[:]) {
+			t.Errorf("size %d: got %x, want %x", n, got, want)
+		}
+
+		got, err := SumReaderAt(bytes.NewReader(data), int64(n), 3)
+		if err != nil {
+			t.Fatalf("size %d: %v", n, err)
+		}
+		if got != want {
+			t.Errorf("size %d: SumReaderAt got %x, want %x", n, got, want)
+		}
+	}
+}
+
+func TestTreeWriteSplits(t *testing.T) {
+	data := treeInput(9*TreeChunkSize + 100)
+	h := NewTree512()
+	h.Write(data)
+	want := h.Sum(nil)
+
+	for _, step := range []int{1, 63, 1000, TreeChunkSize - 1, TreeChunkSize + 3, 3 * TreeChunkSize} {
+		h.Reset()
+		for i := 0; i < len(data); i += step {
+			end := i + step
+			if end > len(data) {
+				end = len(data)
+			}
+			h.Write(data[i:end])
+			if i == 5*step {
+				// Sum must not change the state
+				h.Sum(nil)
+			}
+		}
+		if got := h.Sum(nil); !bytes.Equal(got, want) {
+			t.Errorf("step %d: got %x, want %x", step, got, want)
+		}
+	}
+}
+
+func TestTreeDistinct(t *testing.T) {
+	// A single chunk still goes through the final compression.
+	data := []byte("abc")
+	h := NewTree512()
+	h.Write(data)
+	plain := Sum512(data)
+	if bytes.Equal(h.Sum(nil), plain[:]) {
+		t.Errorf("tree mode output equals sumhash512 output")
+	}
+
+	// Inputs which only differ by trailing zeros have different lengths.
+	a, _ := SumReaderAt(bytes.NewReader(make([]byte, TreeChunkSize)), TreeChunkSize, 0)
+	b, _ := SumReaderAt(bytes.NewReader(make([]byte, TreeChunkSize+1)), TreeChunkSize+1, 0)
+	if a == b {
+		t.Errorf("trailing zero byte does not change the output")
+	}
+}
+
+type failingReaderAt struct {
+	data []byte
+	fail int64
+}
+
+var errTestRead = errors.New("read failed")
+
+func (r failingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.fail {
+		return 0, errTestRead
+	}
+	return bytes.NewReader(r.data).ReadAt(p, off)
+}
+
+// recordingReaderAt records the reads, and fails them.
+type recordingReaderAt struct {
+	mu    sync.Mutex
+	reads [][2]int64 // offset and length
+}
+
+func (r *recordingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	r.mu.Lock()
+	r.reads = append(r.reads, [2]int64{off, int64(len(p))})
+	r.mu.Unlock()
+	return 0, errTestRead
+}
+
+func TestSumReaderAtLarge(t *testing.T) {
+	// Past 2 GiB, the sizes overflow int on 32-bit hosts. The reads fail, so
+	// that the input is not hashed.
+	size := int64(1)<<31 + 100
+	r := &recordingReaderAt{}
+	if _, err := SumReaderAt(r, size, 1); !errors.Is(err, errTestRead) {
+		t.Errorf("got error %v, want %v", err, errTestRead)
+	}
+	if len(r.reads) != 1 || r.reads[0] != [2]int64{0, TreeChunkSize} {
+		t.Errorf("unexpected reads %v", r.reads)
+	}
+
+	last := int(size / TreeChunkSize)
+	tests := []struct {
+		size int64
+		i    int
+		n    int
+	}{
+		{size, 0, TreeChunkSize},
+		{size, last - 1, TreeChunkSize},
+		{size, last, 100},
+		{1 << 40, 1<<27 - 1, TreeChunkSize},
+		{5, 0, 5},
+	}
+	for _, test := range tests {
+		if n := chunkLen(test.size, test.i); n != test.n {
+			t.Errorf("chunk %d of %d bytes: got length %d, want %d", test.i, test.size, n, test.n)
+		}
+	}
+}
+
+func TestSumReaderAtErrors(t *testing.T) {
+	data := treeInput(5 * TreeChunkSize)
+	_, err := SumReaderAt(failingReaderAt{data, 2 * TreeChunkSize}, int64(len(data)), 2)
+	if !errors.Is(err, errTestRead) {
+		t.Errorf("got error %v, want %v", err, errTestRead)
+	}
+
+	// size is beyond the end of the input
+	_, err = SumReaderAt(bytes.NewReader(data), int64(len(data))+1, 2)
+	if err == nil {
+		t.Errorf("no error reading past the end of the input")
+	}
+}
+
+func TestTreeVector(t *testing.T) {
+	tests := []struct {
+		size   int
+		output string
+	}{
+		{0, "0a655707879eadc36d85829fa67aed8b609700183739bcc64ca8309007ee4d16cd4687e453cc59d6c10b7857587b393b25285bdf9fcc28b6b424396449da9946"},
+		{3, "0decfe44528e9f1daafe20eaf053e02e0c5c4474bd1f8f60701e6d2a1d5c3095f7c083a9dc001e85b5cefe09d1d5790470f29f6544d4f7c0f2102647e9ebe56b"},
+		{TreeChunkSize, "5650a2a033a51057da2780e7dd57b029047ba02bfb30129c752ecc497ee4ecc39186ac267994c1a01c93854c2f5a04f2b7da5034c2fc1e0038f412769791f160"},
+		{5*TreeChunkSize + 17, "85f981ba7d0a8baeade51782e7995f72605e0e80523e823abb803d8cb650ade9cb393e1438d92860fba0fefd50cf62445c51711230de0c162cec58294d11b65f"},
+	}
+	for _, test := range tests {
+		sum, err := SumReaderAt(bytes.NewReader(treeInput(test.size)), int64(test.size), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(sum[:]) != test.output {
+			t.Errorf("size %d: got %x, want %s", test.size, sum, test.output)
+		}
+	}
+}
+
+func BenchmarkTree512(b *testing.B) {
+	data := treeInput(1 << 20)
+	h := NewTree512()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
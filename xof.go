@@ -0,0 +1,110 @@
+package sumhash
+
+import (
+	"encoding/binary"
+)
+
+// XOF is an extendable-output function built on a sumhash instance. Its
+// output can be read to any length. See spec/xof.md for the construction.
+type XOF struct {
+	d *Digest
+
+	h       []byte // output of the padded mode, set once reading starts
+	cin     []byte // compression input
+	z       []byte // intermediate compression output
+	out     []byte // current output block
+	unread  int    // number of unread bytes at the end of out
+	ctr     uint64 // index of the next output block
+	reading bool
+}
+
+// NewXOF returns a new XOF computing a sumhash extendable output. The salt is
+// handled as in New: if salt is nil, the output is computed in unsalted mode,
+// and otherwise salt should be BlockSize(c) bytes.
+func NewXOF(c Compressor, salt []byte) *XOF {
+	x := &XOF{d: NewDigest(c, salt)}
+	x.h = make([]byte, c.OutputLen())
+	x.cin = make([]byte, c.InputLen())
+	x.z = make([]byte, c.OutputLen())
+	x.out = make([]byte, c.OutputLen())
+	return x
+}
+
+// NewXOF512 returns a new XOF built on the sumhash512 instance. If salt is
+// not nil, it should be 64 bytes.
+func NewXOF512(salt []byte) *XOF {
+	return NewXOF(SumhashCompressor, salt)
+}
+
+// Write absorbs more data. It panics if called after Read.
+func (x *XOF) Write(p []byte) (int, error) {
+	if x.reading {
+		panic("sumhash: Write after Read")
+	}
+	return x.d.Write(p)
+}
+
+// Read reads more output. It never returns an error.
+func (x *XOF) Read(p []byte) (int, error) {
+	if !x.reading {
+		copy(x.h, x.d.Clone().checkSum())
+		x.reading = true
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		if x.unread == 0 {
+			x.nextBlock()
+		}
+		k := copy(p, x.out[len(x.out)-x.unread:])
+		x.unread -= k
+		p = p[k:]
+	}
+	return n, nil
+}
+
+// Reset resets the XOF to its initial state, keeping its salt.
+func (x *XOF) Reset() {
+	x.d.Reset()
+	x.unread = 0
+	x.ctr = 0
+	x.reading = false
+}
+
+// nextBlock computes the output block of index x.ctr:
+//
+//	Z = C(h || E(ctr) XOR salt)
+//	Y = C(Z || F XOR salt)
+//
+// where E(ctr) holds the counter in its first 8 bytes, and both blocks end
+// with a domain separation byte.
+func (x *XOF) nextBlock() {
+	size := len(x.h)
+	block := x.cin[size:]
+
+	copy(x.cin, x.h)
+	for i := range block {
+		block[i] = 0
+	}
+	binary.LittleEndian.PutUint64(block, x.ctr)
+	block[len(block)-1] = domainXOFCounter
+	x.saltBlock(block)
+	x.d.c.Compress(x.z, x.cin)
+
+	copy(x.cin, x.z)
+	for i := range block {
+		block[i] = 0
+	}
+	block[len(block)-1] = domainXOFOutput
+	x.saltBlock(block)
+	x.d.c.Compress(x.out, x.cin)
+
+	x.unread = len(x.out)
+	x.ctr++
+}
+
+func (x *XOF) saltBlock(block []byte) {
+	if x.d.salt != nil {
+		xorBytes(block, block, x.d.salt)
+	}
+}
@@ -0,0 +1,118 @@
+package sumhash
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"testing"
+)
+
+func TestXOF512Vector(t *testing.T) {
+	var salt [64]byte
+	for i := range salt {
+		salt[i] = byte(i)
+	}
+
+	tests := []struct {
+		input  string
+		salt   []byte
+		output string
+	}{
+		{"", nil, "2752f1ba9b8e55ce06b8b3f56bcb872b660ba9dc57b5f72b66182d67d4d28aba4ba48a2b7d472c9d5589cc5a86e0ea96cc302a3e505c5f1040f6ae0fefe1d267655ee797dd1762d7823623b9e707a6cd7bf3763c60f9c0932a90d45dd5c6f738676c9e66cbaf12627d732545ea547fb61e5684b28edb728780f1a87fc6b089c6"},
+		{"abc", nil, "f3e7eb2a2f2a347212c1a8ea28539533d886ec324a88cf84da60578fc73dc749e84038df90802c61bd00431e0e714124fcc7c67f07f95538e2907e21986fe2942ed7ebd60efdaad6f10ddfb5ae8ede3258c5edb4199780b42f9e1aba0926097b8d0b6b360d8fad9f6590297dc296bb94e8709091384125827469ca2604a67202"},
+		{"abc", salt[:], "08bcfbb6f61ab97be63bb417c41d52f2dd57bc945d4eab9a1494167151c15c575f86d47bf3651c81fb65f43ed107283f1c39fcdbe197b9567fdb672cd88ac404fd95c5c29b4f35736c7e4dc38d3afddf0432527ed682e61619aae36a0904ab00d3582ae5fdb4d6baa44376636e9f298f95c88b059b7342ea55c8a75f169cd176"},
+	}
+	for i, test := range tests {
+		x := NewXOF512(test.salt)
+		io.WriteString(x, test.input)
+		out := make([]byte, 128)
+		x.Read(out)
+		if hex.EncodeToString(out) != test.output {
+			t.Errorf("test vector %d: got %x, want %s", i, out, test.output)
+		}
+	}
+}
+
+func TestXOFConstruction(t *testing.T) {
+	A, err := RandomMatrix(rand.Reader, 8, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := make([]byte, 64)
+	rand.Read(salt)
+	msg := make([]byte, 300)
+	rand.Read(msg)
+
+	for _, s := range [][]byte{nil, salt} {
+		h := New(A, s)
+		h.Write(msg)
+		sum := h.Sum(nil)
+
+		var want []byte
+		for i := 0; i < 3; i++ {
+			cin := make([]byte, 128)
+			copy(cin, sum)
+			binary.LittleEndian.PutUint64(cin[64:], uint64(i))
+			cin[127] = 0x80
+			if s != nil {
+				xorBytes(cin[64:], cin[64:], s)
+			}
+			z := make([]byte, 64)
+			A.Compress(z, cin)
+
+			cin = make([]byte, 128)
+			copy(cin, z)
+			cin[127] = 0x81
+			if s != nil {
+				xorBytes(cin[64:], cin[64:], s)
+			}
+			y := make([]byte, 64)
+			A.Compress(y, cin)
+			want = append(want, y...)
+		}
+
+		x := NewXOF(A, s)
+		x.Write(msg[:100])
+		x.Write(msg[100:])
+		out := make([]byte, len(want))
+		x.Read(out)
+		if !bytes.Equal(out, want) {
+			t.Errorf("XOF output differs from its construction (salted %v)", s != nil)
+		}
+		if bytes.Equal(out[:64], sum) {
+			t.Errorf("XOF output equals the hash output")
+		}
+	}
+}
+
+func TestXOFRead(t *testing.T) {
+	msg := []byte("sumhash xof input")
+	x := NewXOF512(nil)
+	x.Write(msg)
+	want := make([]byte, 1000)
+	x.Read(want)
+
+	x.Reset()
+	x.Write(msg)
+	var got []byte
+	for _, l := range []int{0, 1, 63, 64, 65, 7, 300, 500} {
+		out := make([]byte, l)
+		n, err := x.Read(out)
+		if n != l || err != nil {
+			t.Fatalf("Read returned %d, %v", n, err)
+		}
+		got = append(got, out...)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs when read in pieces")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Write after Read did not panic")
+		}
+	}()
+	x.Write(msg)
+}